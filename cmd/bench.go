@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/bench"
+)
+
+var benchDepth int
+
+var benchCmd = &cobra.Command{
+	Use: "bench",
+	Long: `Runs a fixed benchmark over a built-in list of positions and prints the total
+number of nodes visited and the nodes per second.
+
+The node count is a signature of the engine's behavior: if it changes between
+two commits that weren't meant to change behavior, something is wrong. At the
+default depth, a node count that differs from the expected signature is
+reported as a mismatch.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		results, err := bench.Run(benchDepth)
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
+		cmd.Printf("positions:    %d\n", len(bench.Positions))
+		cmd.Printf("depth:        %d\n", benchDepth)
+		cmd.Printf("nodes:        %d\n", results.Nodes)
+		cmd.Printf("nps:          %d\n", results.NodesPerSecond())
+		cmd.Printf("time elapsed: %s\n", results.Elapsed.Round(time.Millisecond))
+		if benchDepth == bench.DefaultDepth && results.Nodes != bench.Signature {
+			cmd.Printf("signature mismatch: expected %d nodes\n", bench.Signature)
+		}
+	},
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	benchCmd.Flags().IntVarP(&benchDepth, "depth", "d", bench.DefaultDepth, "the ply depth to benchmark to; changing it changes the node signature")
+	rootCmd.AddCommand(benchCmd)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// printFatalError reports an error that ended a command. FEN parse errors are
+// rendered with a caret pointing at the offending part of the FEN string, and
+// position validation errors list each violation on its own line.
+func printFatalError(cmd *cobra.Command, err error) {
+	var fenErr *engine.FenError
+	if errors.As(err, &fenErr) {
+		cmd.Printf("fatal error: invalid FEN\n%s", fenErr.Diagnostic())
+		return
+	}
+
+	var validationErr *engine.ValidationError
+	if errors.As(err, &validationErr) {
+		cmd.Printf("fatal error: invalid position\n")
+		for _, violation := range validationErr.Violations {
+			cmd.Printf("  %s\n", violation.Error())
+		}
+
+		return
+	}
+
+	cmd.Printf("fatal error: %s\n", err.Error())
+}
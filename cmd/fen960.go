@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+var fen960Shredder bool
+
+var fen960Cmd = &cobra.Command{
+	Use: "fen960 <n> [black-n]",
+	Long: `Prints the FEN of the Chess960 starting position with the given index, from
+0 to 959, in the standard Scharnagl numbering; 518 is the standard chess starting
+position.
+
+If a second index is given, it chooses Black's back rank independently of
+White's, producing a "double Fischer random" position.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		indices := make([]int, 0, len(args))
+		for _, arg := range args {
+			index, err := strconv.Atoi(arg)
+			if err != nil {
+				cmd.Printf("fatal error: invalid position index `%s`\n", arg)
+				return
+			}
+
+			indices = append(indices, index)
+		}
+
+		if len(indices) == 1 {
+			indices = append(indices, indices[0])
+		}
+
+		pos, err := engine.MakeDoubleChess960Position(indices[0], indices[1])
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
+		if fen960Shredder {
+			cmd.Printf("%s\n", pos.AsShredderFen())
+		} else {
+			cmd.Printf("%s\n", pos.AsFen())
+		}
+	},
+	Args: cobra.RangeArgs(1, 2),
+}
+
+func init() {
+	fen960Cmd.Flags().BoolVar(&fen960Shredder, "shredder", false, "print the castling field in Shredder-FEN rather than X-FEN")
+	rootCmd.AddCommand(fen960Cmd)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/movegendiff"
+)
+
+var movegenDiffDepth int
+var movegenDiffFile string
+
+var movegenDiffCmd = &cobra.Command{
+	Use: "movegen-diff [fen]",
+	Long: `Compares the moves generated by the engine against a slow reference move
+generator and prints a unified diff for every position where they disagree.
+
+Positions are either read from a file of newline-delimited JSON produced by
+"perft --save-intermediates" (use "-" for standard input), or visited directly
+by walking the game tree from the given FEN to the given depth.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var positions, divergences int
+		check := func(record movegendiff.Record) error {
+			positions++
+			diff, err := movegendiff.Check(record)
+			if err != nil {
+				return err
+			}
+
+			if diff != "" {
+				divergences++
+				cmd.Print(movegendiff.Divergence(record, diff))
+			}
+
+			return nil
+		}
+
+		var err error
+		if movegenDiffFile != "" {
+			err = checkRecordFile(movegenDiffFile, check)
+		} else if len(args) == 1 {
+			var pos *engine.Position
+			pos, err = engine.MakePositionFromFen(args[0], engine.FenLenient(), engine.FenValidate())
+			if err == nil {
+				err = movegendiff.Walk(pos, movegenDiffDepth, check)
+			}
+		} else {
+			cmd.Printf("fatal error: either a FEN or --file is required\n")
+			return
+		}
+
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
+		cmd.Printf("checked %d positions, %d divergences\n", positions, divergences)
+	},
+	Args: cobra.MaximumNArgs(1),
+}
+
+func checkRecordFile(path string, check func(movegendiff.Record) error) error {
+	var input io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+		input = file
+	}
+
+	reader := movegendiff.NewReader(input)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := check(record); err != nil {
+			return err
+		}
+	}
+}
+
+func init() {
+	movegenDiffCmd.Flags().IntVarP(&movegenDiffDepth, "depth", "d", 3, "the ply depth to walk to when given a FEN")
+	movegenDiffCmd.Flags().StringVarP(&movegenDiffFile, "file", "f", "", "a file of positions produced by perft --save-intermediates")
+	rootCmd.AddCommand(movegenDiffCmd)
+}
@@ -1,42 +1,53 @@
 package cmd
 
 import (
-	"encoding/json"
 	"os"
+	"runtime"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/movegendiff"
 	"github.com/swgillespie/apollo-ii/pkg/perft"
 )
 
 var depth int
 var saveIntermediates bool
+var perftVariant string
 
 // perftCmd represents the perft command
 var perftCmd = &cobra.Command{
 	Use:  "perft",
 	Long: "Calculates the PERFT statistics for a given board position.",
 	Run: func(cmd *cobra.Command, args []string) {
-		engine.Initialize()
+		variant, err := engine.LookupVariant(perftVariant)
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
 		if saveIntermediates {
 			// this mode is slightly different than the normal perft in that
 			// it serializes a bunch of information about the state of the
 			// game as it traverses the tree of board positions. it's primarily
-			// used to debug the move generator.
-			err := doIntermediatePerft(args[0], depth)
+			// used to debug the move generator, by feeding the output to
+			// movegen-diff.
+			err := doIntermediatePerft(args[0], depth, variant)
 			if err != nil {
-				cmd.Printf("fatal error: %s\n", err.Error())
+				printFatalError(cmd, err)
 			}
 
 			return
 		}
 
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
 		start := time.Now()
-		results, err := perft.Perft(args[0], depth)
+		results, err := perft.Perft(args[0], depth, engine.FenVariant(variant))
 		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
 		if err != nil {
-			cmd.Printf("fatal error: %s\n", err.Error())
+			printFatalError(cmd, err)
 			return
 		}
 
@@ -49,57 +60,35 @@ var perftCmd = &cobra.Command{
 		cmd.Printf("checks:      %d\n", results.Checks)
 		cmd.Printf("checkmates:  %d\n", results.Checkmates)
 		cmd.Printf("\ntime elapsed: %s\n", elapsed)
+
+		allocs := after.Mallocs - before.Mallocs
+		bytes := after.TotalAlloc - before.TotalAlloc
+		cmd.Printf("\nallocations: %d (%.2f per node)\n", allocs, float64(allocs)/float64(results.Nodes))
+		cmd.Printf("bytes:       %d (%.2f per node)\n", bytes, float64(bytes)/float64(results.Nodes))
+		cmd.Printf("gc cycles:   %d\n", after.NumGC-before.NumGC)
 	},
 	Args: cobra.ExactArgs(1),
 }
 
-type intermediatePosition struct {
-	Fen   string
-	Moves []string
-}
-
-var intermediatePositions []intermediatePosition
-
-func doIntermediatePerft(fenStr string, depth int) error {
-	pos, err := engine.MakePositionFromFen(fenStr)
+func doIntermediatePerft(fenStr string, depth int, variant engine.Variant) error {
+	pos, err := engine.MakePositionFromFen(fenStr, engine.FenLenient(), engine.FenValidate(), engine.FenVariant(variant))
 	if err != nil {
 		return err
 	}
 
-	intermediatePerftImpl(pos, depth)
-	jsonString, err := json.MarshalIndent(intermediatePositions, "", "  ")
-	if err != nil {
+	// positions are streamed out as they are visited, one JSON object per
+	// line, so that deep traversals don't need to be held in memory.
+	writer := movegendiff.NewWriter(os.Stdout)
+	if err := movegendiff.Walk(pos, depth, writer.Write); err != nil {
 		return err
 	}
 
-	os.Stdout.Write(jsonString)
-	os.Stdout.WriteString("\n")
-	return nil
-}
-
-func intermediatePerftImpl(pos *engine.Position, depth int) {
-	if depth == 0 {
-		return
-	}
-
-	fen := pos.AsFen()
-	intermediate := intermediatePosition{fen, make([]string, 0)}
-
-	for _, mov := range pos.PseudolegalMoves() {
-		newPos := pos.Clone()
-		toMove := pos.SideToMove()
-		newPos.ApplyMove(mov)
-		if !newPos.IsCheck(toMove) {
-			intermediate.Moves = append(intermediate.Moves, mov.UciString())
-			intermediatePerftImpl(newPos, depth-1)
-		}
-	}
-
-	intermediatePositions = append(intermediatePositions, intermediate)
+	return writer.Flush()
 }
 
 func init() {
 	perftCmd.Flags().IntVarP(&depth, "depth", "d", 3, "the ply depth to search to")
-	perftCmd.Flags().BoolVar(&saveIntermediates, "save-intermediates", false, "write intermediate move states to standard out")
+	perftCmd.Flags().BoolVar(&saveIntermediates, "save-intermediates", false, "stream intermediate move states to standard out as newline-delimited JSON")
+	perftCmd.Flags().StringVar(&perftVariant, "variant", engine.Standard.Name(), "the chess variant to play (chess, antichess, 3check, kingofthehill, crazyhouse, atomic, racingkings or horde)")
 	rootCmd.AddCommand(perftCmd)
 }
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/perft"
+)
+
+var bisectDepth int
+var bisectEngine string
+
+var perftBisectCmd = &cobra.Command{
+	Use: "perft-bisect [fen]",
+	Long: `Compares PERFT divide output for a position against an external UCI engine
+that supports "go perft", descending into the first move whose node counts
+differ until it finds the position where the two engines disagree about which
+moves are legal.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if bisectEngine == "" {
+			cmd.Printf("fatal error: --engine is required\n")
+			return
+		}
+
+		external, err := perft.StartExternalEngine(bisectEngine)
+		if err != nil {
+			cmd.Printf("fatal error: failed to start engine: %s\n", err.Error())
+			return
+		}
+
+		defer external.Close()
+
+		// castles in Chess960 positions are only unambiguous in UCI
+		// notation when both engines write them as king-takes-rook.
+		pos, err := engine.MakePositionFromFen(args[0], engine.FenLenient())
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
+		opts := engine.CurrentOptions()
+		if pos.IsChess960() {
+			opts.UciChess960 = true
+			if err := external.SetOption("UCI_Chess960", "true"); err != nil {
+				cmd.Printf("fatal error: failed to configure engine: %s\n", err.Error())
+				return
+			}
+		}
+
+		results, err := perft.Bisect(args[0], bisectDepth, opts, external)
+		if err != nil {
+			printFatalError(cmd, err)
+			return
+		}
+
+		if !results.Found {
+			cmd.Printf("no divergence found at depth %d\n", bisectDepth)
+			return
+		}
+
+		cmd.Printf("divergence found after moves: %s\n", strings.Join(results.Path, " "))
+		cmd.Printf("fen:     %s\n", results.Fen)
+		cmd.Printf("missing: %s\n", strings.Join(results.Missing, " "))
+		cmd.Printf("extra:   %s\n", strings.Join(results.Extra, " "))
+	},
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	perftBisectCmd.Flags().IntVarP(&bisectDepth, "depth", "d", 3, "the ply depth to start bisecting from")
+	perftBisectCmd.Flags().StringVarP(&bisectEngine, "engine", "e", "", "path to a UCI engine binary that supports \"go perft\"")
+	rootCmd.AddCommand(perftBisectCmd)
+}
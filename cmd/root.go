@@ -3,15 +3,162 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
 
 	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
 )
 
+var cpuProfile string
+var memProfile string
+var traceFile string
+var blockProfile string
+var debugChecks bool
+var configFile string
+
+// files that are open for the duration of a profiled command, closed by
+// stopProfiling.
+var cpuProfileFile, traceOutputFile *os.File
+
 var rootCmd = &cobra.Command{
 	Use:   "apollo-ii",
 	Short: "The Apollo chess engine",
 	Long: `Apollo is a chess engine capable of playing chess using the UCI communications protocol.
 It is also capable of analyzing board positions.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := loadOptions(); err != nil {
+			return err
+		}
+
+		return startProfiling()
+	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		return stopProfiling()
+	},
+}
+
+// loadOptions sets the engine's options from the configuration file, if one
+// was given, and then from the flags that override it.
+func loadOptions() error {
+	if configFile != "" {
+		opts, err := engine.LoadOptions(configFile)
+		if err != nil {
+			return err
+		}
+
+		if err := engine.SetOptions(opts); err != nil {
+			return err
+		}
+	}
+
+	if debugChecks {
+		engine.SetDebugChecks(true)
+	}
+
+	return nil
+}
+
+// startProfiling starts any profiles requested on the command line. Profiles
+// that are collected continuously (CPU, trace and block) are started here;
+// the heap profile is a snapshot that is taken when profiling stops.
+func startProfiling() error {
+	if cpuProfile != "" {
+		file, err := os.Create(cpuProfile)
+		if err != nil {
+			return err
+		}
+
+		if err := pprof.StartCPUProfile(file); err != nil {
+			file.Close()
+			return err
+		}
+
+		cpuProfileFile = file
+	}
+
+	// cobra doesn't run PersistentPostRunE when PersistentPreRunE fails, so a
+	// CPU profile that has already started has to be stopped here.
+	if traceFile != "" {
+		file, err := os.Create(traceFile)
+		if err != nil {
+			stopCPUProfile()
+			return err
+		}
+
+		if err := trace.Start(file); err != nil {
+			file.Close()
+			stopCPUProfile()
+			return err
+		}
+
+		traceOutputFile = file
+	}
+
+	if blockProfile != "" {
+		runtime.SetBlockProfileRate(1)
+	}
+
+	return nil
+}
+
+// stopProfiling stops every running profile and writes out the ones that are
+// only written at exit.
+func stopProfiling() error {
+	if err := stopCPUProfile(); err != nil {
+		return err
+	}
+
+	if traceOutputFile != nil {
+		trace.Stop()
+		if err := traceOutputFile.Close(); err != nil {
+			return err
+		}
+	}
+
+	if blockProfile != "" {
+		if err := writeProfile("block", blockProfile); err != nil {
+			return err
+		}
+	}
+
+	if memProfile != "" {
+		// get up-to-date statistics on live objects.
+		runtime.GC()
+		if err := writeProfile("allocs", memProfile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopCPUProfile stops the CPU profile, if one is running, and closes its
+// file.
+func stopCPUProfile() error {
+	if cpuProfileFile == nil {
+		return nil
+	}
+
+	pprof.StopCPUProfile()
+	err := cpuProfileFile.Close()
+	cpuProfileFile = nil
+	return err
+}
+
+func writeProfile(name, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := pprof.Lookup(name).WriteTo(file, 0); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -22,3 +169,12 @@ func Execute() {
 		os.Exit(1)
 	}
 }
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&cpuProfile, "cpuprofile", "", "write a CPU profile to this file")
+	rootCmd.PersistentFlags().StringVar(&memProfile, "memprofile", "", "write a memory allocation profile to this file")
+	rootCmd.PersistentFlags().StringVar(&traceFile, "trace", "", "write an execution trace to this file")
+	rootCmd.PersistentFlags().StringVar(&blockProfile, "blockprofile", "", "write a goroutine blocking profile to this file")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "read engine options from this JSON file, keyed by UCI option name")
+	rootCmd.PersistentFlags().BoolVar(&debugChecks, "debug-checks", false, "check the consistency of every position after every move (slow)")
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/version"
+)
+
+var uciPositionSyntaxError = errors.New("expected `position startpos` or `position fen <fen>`")
+
+// uciCmd speaks the UCI protocol on standard input and output. There is no
+// search yet, so it can set options and set up positions but not play.
+var uciCmd = &cobra.Command{
+	Use:  "uci",
+	Long: "Communicates with a chess GUI using the UCI protocol on standard input and output.",
+	Run: func(cmd *cobra.Command, args []string) {
+		session := uciSession{out: cmd.OutOrStdout(), pos: engine.MakeDefaultPosition()}
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if !session.handle(strings.Fields(scanner.Text())) {
+				return
+			}
+		}
+	},
+}
+
+// uciSession is the state of the engine between UCI commands.
+type uciSession struct {
+	out io.Writer
+	pos *engine.Position
+}
+
+// handle carries out a single UCI command, returning false if the engine
+// should exit. Commands that the engine doesn't know are ignored, as the
+// protocol asks.
+func (s *uciSession) handle(fields []string) bool {
+	if len(fields) == 0 {
+		return true
+	}
+
+	switch fields[0] {
+	case "uci":
+		fmt.Fprintf(s.out, "id name Apollo %s\n", version.Version)
+		fmt.Fprintln(s.out, "id author Sean Gillespie")
+		for _, option := range engine.UciOptions() {
+			fmt.Fprintln(s.out, option)
+		}
+
+		fmt.Fprintln(s.out, "uciok")
+	case "isready":
+		fmt.Fprintln(s.out, "readyok")
+	case "setoption":
+		if err := setUciOption(fields[1:]); err != nil {
+			fmt.Fprintf(s.out, "info string %s\n", err)
+		}
+	case "ucinewgame":
+		s.pos = engine.MakeDefaultPosition()
+	case "position":
+		// a position that can't be set up leaves the current one alone, so
+		// that the error is all the GUI sees of it.
+		pos, err := parseUciPosition(fields[1:])
+		if err != nil {
+			fmt.Fprintf(s.out, "info string %s\n", err)
+			return true
+		}
+
+		s.pos = pos
+	case "go":
+		fmt.Fprintln(s.out, "info string searching is not implemented yet")
+	case "quit":
+		return false
+	}
+
+	return true
+}
+
+// setUciOption carries out the arguments of a "setoption" command, which are
+// of the form "name <name> [value <value>]". Names and values may contain
+// spaces.
+func setUciOption(args []string) error {
+	if len(args) < 2 || args[0] != "name" {
+		return errors.New("expected `setoption name <name> [value <value>]`")
+	}
+
+	name, value := strings.Join(args[1:], " "), ""
+	for i, arg := range args {
+		if arg == "value" {
+			name, value = strings.Join(args[1:i], " "), strings.Join(args[i+1:], " ")
+			break
+		}
+	}
+
+	opts := engine.CurrentOptions()
+	if err := opts.SetUciOption(name, value); err != nil {
+		return err
+	}
+
+	return engine.SetOptions(opts)
+}
+
+// parseUciPosition sets up the position described by the arguments of a
+// "position" command, which are either "startpos" or "fen" and a FEN string,
+// optionally followed by "moves" and the moves played since, in UCI notation.
+func parseUciPosition(args []string) (*engine.Position, error) {
+	moves := len(args)
+	for i, arg := range args {
+		if arg == "moves" {
+			moves = i
+			break
+		}
+	}
+
+	var pos *engine.Position
+	switch {
+	case moves == 1 && args[0] == "startpos":
+		pos = engine.MakeDefaultPosition()
+	case moves > 1 && args[0] == "fen":
+		fenOpts := []engine.FenOption{engine.FenValidate()}
+		if engine.CurrentOptions().UciChess960 {
+			fenOpts = append(fenOpts, engine.FenChess960())
+		}
+
+		var err error
+		pos, err = engine.MakePositionFromFen(strings.Join(args[1:moves], " "), fenOpts...)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, uciPositionSyntaxError
+	}
+
+	if moves == len(args) {
+		return pos, nil
+	}
+
+	for _, uci := range args[moves+1:] {
+		mov, err := pos.ParseUciMove(uci)
+		if err != nil {
+			return nil, err
+		}
+
+		pos.ApplyMove(mov)
+	}
+
+	return pos, nil
+}
+
+func init() {
+	rootCmd.AddCommand(uciCmd)
+}
@@ -0,0 +1,78 @@
+// Package bench implements apollo's fixed benchmark. Running the benchmark
+// visits every position in a fixed list to a fixed depth and reports the
+// total number of nodes visited, which acts as a signature of the engine's
+// behavior, and the nodes per second, which tracks its performance.
+//
+// Until the engine can search, the benchmark is a perft of each position.
+package bench
+
+import (
+	"time"
+
+	"github.com/swgillespie/apollo-ii/pkg/perft"
+)
+
+// DefaultDepth is the depth that each position is benchmarked to. Changing it
+// changes the node signature.
+const DefaultDepth = 4
+
+// Signature is the total number of nodes that a benchmark run to DefaultDepth
+// visits. It only changes when the engine's behavior is meant to change, and
+// then it has to be updated along with that change.
+const Signature uint64 = 15159834
+
+// Positions is the list of positions that are benchmarked. They are chosen to
+// exercise a wide variety of move generator features: castling, en-passant,
+// promotion, checks and pins, and sparse endgames. Changing this list changes
+// the node signature.
+var Positions = [...]string{
+	"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+	"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+	"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+	"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+	"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8",
+	"r4rk1/1pp1qppp/p1np1n2/2b1p1B1/2B1P1b1/P1NP1N2/1PP1QPPP/R4RK1 w - - 0 10",
+	"r1bqkb1r/pppp1ppp/2n2n2/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4",
+	"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+	"2kr3r/pp1q1ppp/2n1bn2/2b1p3/4P3/2NP1N2/PPPQBPPP/R1B1K2R w KQ - 3 10",
+	"8/8/4k3/8/2p5/8/B2P2K1/8 w - - 0 1",
+	"8/P1k5/K7/8/8/8/8/8 w - - 0 1",
+	"4k3/1P6/8/8/8/8/K7/8 w - - 0 1",
+	"8/5k2/8/3Pp3/8/8/5K2/8 w - e6 0 2",
+	"6k1/5ppp/8/8/8/8/5PPP/3R2K1 w - - 0 1",
+}
+
+// Results are the results of a benchmark run.
+type Results struct {
+	// The total number of nodes visited across every position.
+	Nodes uint64
+
+	// The total time spent visiting them.
+	Elapsed time.Duration
+}
+
+// NodesPerSecond returns the average number of nodes visited per second.
+func (r *Results) NodesPerSecond() uint64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+
+	return uint64(float64(r.Nodes) / r.Elapsed.Seconds())
+}
+
+// Run runs the benchmark to the given depth.
+func Run(depth int) (*Results, error) {
+	results := new(Results)
+	for _, fen := range Positions {
+		start := time.Now()
+		perftResults, err := perft.Perft(fen, depth)
+		results.Elapsed += time.Since(start)
+		if err != nil {
+			return nil, err
+		}
+
+		results.Nodes += perftResults.Nodes
+	}
+
+	return results, nil
+}
@@ -0,0 +1,61 @@
+package bench
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/reference"
+)
+
+func referenceNodes(pos *engine.Position, depth int) uint64 {
+	if depth == 0 {
+		return 1
+	}
+
+	var nodes uint64
+	for _, mov := range reference.LegalMoves(pos) {
+		newPos := pos.Clone()
+		newPos.ApplyMove(mov)
+		nodes += referenceNodes(newPos, depth-1)
+	}
+
+	return nodes
+}
+
+func TestBenchSignature(t *testing.T) {
+	if testing.Short() {
+		t.Skip("a full benchmark run is too slow for -short")
+	}
+
+	t.Parallel()
+	results, err := Run(DefaultDepth)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, Signature, results.Nodes)
+}
+
+// The node count of a shallow benchmark run should match the number of nodes
+// that the reference move generator visits for the same positions.
+func TestBenchMatchesReference(t *testing.T) {
+	t.Parallel()
+	const depth = 2
+	var expected uint64
+	for _, fen := range Positions {
+		pos, err := engine.MakePositionFromFen(fen)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		expected += referenceNodes(pos, depth)
+	}
+
+	results, err := Run(depth)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, expected, results.Nodes)
+}
@@ -0,0 +1,43 @@
+package engine
+
+// Antichess is the variant in which each side tries to lose all of its
+// pieces. Captures are compulsory, the king is an ordinary piece that can be
+// captured and doesn't need to be kept out of check, and there is no
+// castling. A side with no legal moves, including one that has lost all of
+// its pieces, wins.
+//
+// Some servers also allow pawns to promote to a king in antichess. That isn't
+// supported here, since the move encoding has no room for it.
+var Antichess Variant = antichess{}
+
+type antichess struct {
+	standard
+}
+
+func (antichess) Name() string {
+	return "antichess"
+}
+
+func (antichess) PseudolegalMoves(pos *Position, list *MoveList) {
+	generatePseudolegalMoves(pos, list)
+	list.Filter(func(mov Move) bool {
+		return !mov.IsCastle()
+	})
+}
+
+// LegalMoves keeps only the captures if there are any, since captures are
+// compulsory, and otherwise every move.
+func (antichess) LegalMoves(pos *Position, list *MoveList, scratch *Position) {
+	for i := 0; i < list.Len(); i++ {
+		if list.At(i).IsCapture() {
+			list.Filter(Move.IsCapture)
+			return
+		}
+	}
+}
+
+// NoMovesOutcome returns a win for the side to move, since running out of
+// moves is the goal.
+func (antichess) NoMovesOutcome(pos *Position) Result {
+	return WinFor(pos.SideToMove())
+}
@@ -0,0 +1,102 @@
+package engine
+
+// Atomic is the variant in which every capture is an explosion: the captured
+// piece, the capturing piece and every piece other than a pawn on the squares
+// around the capture are removed from the board. A side wins by exploding the
+// opposing king. Kings can't capture, since they'd explode themselves, and a
+// king that is touching the opposing king can't be checked, since capturing
+// it would explode both kings.
+var Atomic Variant = atomic{}
+
+type atomic struct {
+	standard
+}
+
+func (atomic) Name() string {
+	return "atomic"
+}
+
+// PseudolegalMoves generates the standard pseudo-legal moves, except for king
+// captures.
+func (atomic) PseudolegalMoves(pos *Position, list *MoveList) {
+	generatePseudolegalMoves(pos, list)
+	kings := pos.Kings(pos.SideToMove())
+	list.Filter(func(mov Move) bool {
+		return !mov.IsCapture() || !kings.Test(mov.Source())
+	})
+}
+
+// LegalMoves filters out the moves that explode the mover's own king, and
+// the moves that leave it in check, unless they explode the opposing king
+// too, which ends the game.
+func (atomic) LegalMoves(pos *Position, list *MoveList, scratch *Position) {
+	toMove := pos.SideToMove()
+	list.Filter(func(mov Move) bool {
+		*scratch = *pos
+		scratch.ApplyMove(mov)
+		if scratch.Kings(toMove).Empty() {
+			return false
+		}
+
+		return scratch.Kings(toMove.Toggle()).Empty() || !scratch.IsCheck(toMove)
+	})
+}
+
+// VariantOutcome returns a win for the side whose king hasn't exploded.
+func (atomic) VariantOutcome(pos *Position) (Result, bool) {
+	for _, color := range [...]Color{White, Black} {
+		if pos.Kings(color).Empty() {
+			return WinFor(color.Toggle()), true
+		}
+	}
+
+	return Draw, false
+}
+
+// AfterMove explodes the piece that just captured, along with everything
+// around it.
+func (atomic) AfterMove(pos *Position, mov Move) {
+	if !mov.IsCapture() {
+		return
+	}
+
+	pos.explode(mov.Destination())
+}
+
+// explodesOnCapture returns whether or not captures explode in this
+// position's variant.
+func (p *Position) explodesOnCapture() bool {
+	return p.variant == Atomic
+}
+
+// kingsTouching returns whether or not the two sides' kings are on adjacent
+// squares.
+func (p *Position) kingsTouching() bool {
+	kings := p.Kings(White).Iter()
+	for king, next := kings.Next(); next; king, next = kings.Next() {
+		if KingAttacks(king)&p.Kings(Black) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// explode removes the piece on the given square and every piece other than
+// a pawn around it, along with any castling rights that depended on the
+// pieces that were removed.
+func (p *Position) explode(center Square) {
+	blast := KingAttacks(center) &^ (p.Pawns(White) | p.Pawns(Black))
+	blast.Set(center)
+	blast &= p.White() | p.Black()
+	squares := blast.Iter()
+	for square, next := squares.Next(); next; square, next = squares.Next() {
+		piece := p.pieceAtOrPanic(square)
+		p.removePieceOrPanic(square)
+		for _, kingside := range [...]bool{true, false} {
+			if piece.kind == King || square == p.castleRooks[piece.color][castleSide(kingside)] {
+				p.castleStatus &= ^castleFlag(piece.color, kingside)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The attack tables are generated, so these check them against well-known
+// totals over the whole board, without any initialization.
+func TestAttackTableTotals(t *testing.T) {
+	t.Parallel()
+	var knights, kings, bishops, rooks int
+	pawns := [2]int{}
+	for square := A1; square <= H8; square++ {
+		knights += KnightAttacks(square).Count()
+		kings += KingAttacks(square).Count()
+		bishops += BishopAttacks(square, EmptyBitboard).Count()
+		rooks += RookAttacks(square, EmptyBitboard).Count()
+		for _, color := range [...]Color{White, Black} {
+			pawns[color] += PawnAttacks(square, color).Count()
+		}
+	}
+
+	assert.Equal(t, 336, knights)
+	assert.Equal(t, 420, kings)
+	assert.Equal(t, 560, bishops)
+	assert.Equal(t, 896, rooks)
+	assert.Equal(t, [2]int{98, 98}, pawns)
+}
+
+func TestAttacks(t *testing.T) {
+	t.Parallel()
+	t.Run("knight-corner", func(tt *testing.T) {
+		expected := EmptyBitboard
+		expected.Set(B3)
+		expected.Set(C2)
+		assert.Equal(tt, expected, KnightAttacks(A1))
+	})
+
+	t.Run("pawn-edge", func(tt *testing.T) {
+		expected := EmptyBitboard
+		expected.Set(G3)
+		assert.Equal(tt, expected, PawnAttacks(H2, White))
+		assert.Equal(tt, EmptyBitboard, PawnAttacks(H8, White))
+	})
+
+	t.Run("rook-blocked", func(tt *testing.T) {
+		occupancy := EmptyBitboard
+		occupancy.Set(D6)
+		occupancy.Set(F4)
+		expected := EmptyBitboard
+		for _, square := range [...]Square{D5, D6, D3, D2, D1, E4, F4, C4, B4, A4} {
+			expected.Set(square)
+		}
+
+		assert.Equal(tt, expected, RookAttacks(D4, occupancy))
+	})
+}
+
+func TestBetweenAndLine(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name          string
+		a, b          Square
+		between, line Bitboard
+	}{
+		{"file", E1, E4, squares(E2, E3), FullBitboard.File(FileE)},
+		{"rank", H3, C3, squares(G3, F3, E3, D3), FullBitboard.Rank(Rank3)},
+		{"diagonal", B2, E5, squares(C3, D4), squares(A1, B2, C3, D4, E5, F6, G7, H8)},
+		{"antidiagonal", G2, E4, squares(F3), squares(H1, G2, F3, E4, D5, C6, B7, A8)},
+		{"adjacent", D4, D5, EmptyBitboard, FullBitboard.File(FileD)},
+		{"unaligned", A1, B3, EmptyBitboard, EmptyBitboard},
+		{"same-square", C3, C3, EmptyBitboard, EmptyBitboard},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.between, Between(test.a, test.b))
+			assert.Equal(tt, test.between, Between(test.b, test.a))
+			assert.Equal(tt, test.line, Line(test.a, test.b))
+			assert.Equal(tt, test.line, Line(test.b, test.a))
+		})
+	}
+}
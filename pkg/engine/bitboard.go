@@ -17,6 +17,12 @@ const (
 
 	// FullBitboard is the full bitboard, the complement of the empty set.
 	FullBitboard = Bitboard(0xFFFFFFFFFFFFFFFF)
+
+	// LightSquares is the bitboard of the light squares, e.g. h1.
+	LightSquares = Bitboard(0x55AA55AA55AA55AA)
+
+	// DarkSquares is the bitboard of the dark squares, e.g. a1.
+	DarkSquares = Bitboard(0xAA55AA55AA55AA55)
 )
 
 // Masks for masking off particular ranks or files, indexed by rank or file.
@@ -40,6 +46,17 @@ var fileMasks = [...]uint64{
 	0x4040404040404040,
 	0x8080808080808080}
 
+// Masks for the files on either side of each file, indexed by file.
+var adjacentFileMasks = [...]uint64{
+	0x0202020202020202,
+	0x0505050505050505,
+	0x0A0A0A0A0A0A0A0A,
+	0x1414141414141414,
+	0x2828282828282828,
+	0x5050505050505050,
+	0xA0A0A0A0A0A0A0A0,
+	0x4040404040404040}
+
 // Test tests whether or not a square is a member of this bitboard.
 func (b Bitboard) Test(square Square) bool {
 	return (uint64(b) & (uint64(1) << square)) != 0
@@ -72,6 +89,117 @@ func (b Bitboard) Empty() bool {
 	return b == 0
 }
 
+// LSB returns the lowest square in this bitboard, or InvalidSquare if it is
+// empty.
+func (b Bitboard) LSB() Square {
+	if b == 0 {
+		return InvalidSquare
+	}
+
+	return Square(bits.TrailingZeros64(uint64(b)))
+}
+
+// MSB returns the highest square in this bitboard, or InvalidSquare if it is
+// empty.
+func (b Bitboard) MSB() Square {
+	if b == 0 {
+		return InvalidSquare
+	}
+
+	return Square(63 - bits.LeadingZeros64(uint64(b)))
+}
+
+// PopLSB removes the lowest square from this bitboard and returns it, or
+// returns InvalidSquare if it is empty.
+func (b *Bitboard) PopLSB() Square {
+	square := b.LSB()
+	*b &= *b - 1
+	return square
+}
+
+// Shift moves every square in this bitboard one step in the given direction.
+// Squares that would move off the board are dropped rather than wrapping
+// around to the other side.
+func (b Bitboard) Shift(direction Direction) Bitboard {
+	notFileA := Bitboard(^fileMasks[FileA])
+	notFileH := Bitboard(^fileMasks[FileH])
+	switch direction {
+	case North:
+		return b << 8
+	case NorthEast:
+		return (b & notFileH) << 9
+	case East:
+		return (b & notFileH) << 1
+	case SouthEast:
+		return (b & notFileH) >> 7
+	case South:
+		return b >> 8
+	case SouthWest:
+		return (b & notFileA) >> 9
+	case West:
+		return (b & notFileA) >> 1
+	case NorthWest:
+		return (b & notFileA) << 7
+	}
+
+	panic("unknown direction")
+}
+
+// FlipVertical mirrors this bitboard top to bottom, so that a1 becomes a8.
+func (b Bitboard) FlipVertical() Bitboard {
+	return Bitboard(bits.ReverseBytes64(uint64(b)))
+}
+
+// FlipHorizontal mirrors this bitboard left to right, so that a1 becomes h1.
+func (b Bitboard) FlipHorizontal() Bitboard {
+	// reversing every bit rotates the board by 180 degrees, which flipping
+	// it vertically again turns into a horizontal flip.
+	return Bitboard(bits.ReverseBytes64(bits.Reverse64(uint64(b))))
+}
+
+// NorthFill returns this bitboard with every square north of each of its
+// squares added.
+func (b Bitboard) NorthFill() Bitboard {
+	b |= b << 8
+	b |= b << 16
+	b |= b << 32
+	return b
+}
+
+// SouthFill returns this bitboard with every square south of each of its
+// squares added.
+func (b Bitboard) SouthFill() Bitboard {
+	b |= b >> 8
+	b |= b >> 16
+	b |= b >> 32
+	return b
+}
+
+// FileFill returns every file that has a square in this bitboard.
+func (b Bitboard) FileFill() Bitboard {
+	return b.NorthFill() | b.SouthFill()
+}
+
+// AdjacentFiles returns the bitboard of the files on either side of the given
+// file.
+func AdjacentFiles(file File) Bitboard {
+	return Bitboard(adjacentFileMasks[file])
+}
+
+// PassedPawnSpan returns the squares in front of a pawn of the given color on
+// the given square, on its own file and the adjacent ones. A pawn is passed if
+// there are no enemy pawns on any of them.
+func PassedPawnSpan(color Color, square Square) Bitboard {
+	return passedPawnTable[color][square]
+}
+
+// KingZone returns the squares around a king of the given color on the given
+// square, including the king's own square, along with the three squares two
+// ranks in front of it, toward the opponent.
+func KingZone(color Color, square Square) Bitboard {
+	return kingZoneTable[color][square]
+}
+
 func (b Bitboard) String() string {
 	buf := new(bytes.Buffer)
 	for rank := Rank8; ; rank-- {
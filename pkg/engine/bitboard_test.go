@@ -144,6 +144,185 @@ func TestBitboards(t *testing.T) {
 	})
 }
 
+// squares returns the bitboard containing exactly the given squares.
+func squares(squares ...Square) Bitboard {
+	board := EmptyBitboard
+	for _, square := range squares {
+		board.Set(square)
+	}
+
+	return board
+}
+
+func TestBitboardScans(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name     string
+		board    Bitboard
+		lsb, msb Square
+	}{
+		{"empty", EmptyBitboard, InvalidSquare, InvalidSquare},
+		{"one", squares(E4), E4, E4},
+		{"corners", squares(A1, H8), A1, H8},
+		{"many", squares(C2, F5, B7, G3), C2, B7},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.lsb, test.board.LSB())
+			assert.Equal(tt, test.msb, test.board.MSB())
+
+			// popping every square yields them all in iteration order.
+			board := test.board
+			var popped []Square
+			for !board.Empty() {
+				popped = append(popped, board.PopLSB())
+			}
+
+			var iterated []Square
+			iter := test.board.Iter()
+			for sq, ok := iter.Next(); ok; sq, ok = iter.Next() {
+				iterated = append(iterated, sq)
+			}
+
+			assert.Equal(tt, iterated, popped)
+			assert.Equal(tt, InvalidSquare, board.PopLSB())
+		})
+	}
+}
+
+func TestBitboardShift(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name      string
+		board     Bitboard
+		direction Direction
+		expected  Bitboard
+	}{
+		{"north", squares(E4, A8), North, squares(E5)},
+		{"north-east", squares(E4, H4), NorthEast, squares(F5)},
+		{"east", squares(E4, H4), East, squares(F4)},
+		{"south-east", squares(E4, H4, E1), SouthEast, squares(F3)},
+		{"south", squares(E4, A1), South, squares(E3)},
+		{"south-west", squares(E4, A4), SouthWest, squares(D3)},
+		{"west", squares(E4, A4), West, squares(D4)},
+		{"north-west", squares(E4, A4, E8), NorthWest, squares(D5)},
+		{"full-east", FullBitboard, East, FullBitboard &^ FullBitboard.File(FileA)},
+		{"full-south", FullBitboard, South, FullBitboard &^ FullBitboard.Rank(Rank8)},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.expected, test.board.Shift(test.direction))
+		})
+	}
+}
+
+func TestBitboardFlips(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name                 string
+		board                Bitboard
+		vertical, horizontal Bitboard
+	}{
+		{"empty", EmptyBitboard, EmptyBitboard, EmptyBitboard},
+		{"corner", squares(A1), squares(A8), squares(H1)},
+		{"center", squares(D4, E6), squares(D5, E3), squares(E4, D6)},
+		{"rank", FullBitboard.Rank(Rank2), FullBitboard.Rank(Rank7), FullBitboard.Rank(Rank2)},
+		{"file", FullBitboard.File(FileB), FullBitboard.File(FileB), FullBitboard.File(FileG)},
+		{"light-squares", LightSquares, DarkSquares, DarkSquares},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.vertical, test.board.FlipVertical())
+			assert.Equal(tt, test.horizontal, test.board.FlipHorizontal())
+			assert.Equal(tt, test.board, test.board.FlipVertical().FlipVertical())
+			assert.Equal(tt, test.board, test.board.FlipHorizontal().FlipHorizontal())
+		})
+	}
+}
+
+func TestBitboardFills(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name         string
+		board        Bitboard
+		north, south Bitboard
+	}{
+		{"empty", EmptyBitboard, EmptyBitboard, EmptyBitboard},
+		{"one", squares(C3), squares(C3, C4, C5, C6, C7, C8), squares(C3, C2, C1)},
+		{"two-files", squares(A8, H1), squares(A8) | FullBitboard.File(FileH), FullBitboard.File(FileA) | squares(H1)},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.north, test.board.NorthFill())
+			assert.Equal(tt, test.south, test.board.SouthFill())
+			assert.Equal(tt, test.north|test.south, test.board.FileFill())
+		})
+	}
+}
+
+func TestBitboardMasks(t *testing.T) {
+	t.Parallel()
+	t.Run("adjacent-files", func(tt *testing.T) {
+		for _, test := range [...]struct {
+			file     File
+			expected Bitboard
+		}{
+			{FileA, FullBitboard.File(FileB)},
+			{FileD, FullBitboard.File(FileC) | FullBitboard.File(FileE)},
+			{FileH, FullBitboard.File(FileG)},
+		} {
+			assert.Equal(tt, test.expected, AdjacentFiles(test.file), "%s", test.file)
+		}
+	})
+
+	t.Run("square-colors", func(tt *testing.T) {
+		assert.Equal(tt, FullBitboard, LightSquares|DarkSquares)
+		assert.Equal(tt, EmptyBitboard, LightSquares&DarkSquares)
+		for _, test := range [...]struct {
+			square Square
+			light  bool
+		}{
+			{A1, false}, {H1, true}, {A8, true}, {H8, false}, {D1, true}, {E4, true}, {D4, false},
+		} {
+			assert.Equal(tt, test.light, LightSquares.Test(test.square), "%s", test.square)
+		}
+	})
+
+	for _, test := range [...]struct {
+		name     string
+		color    Color
+		square   Square
+		expected Bitboard
+	}{
+		{"passed-white-center", White, E5, squares(D6, E6, F6, D7, E7, F7, D8, E8, F8)},
+		{"passed-white-edge", White, A6, squares(A7, B7, A8, B8)},
+		{"passed-black", Black, H3, squares(G2, H2, G1, H1)},
+		{"passed-last-rank", White, C8, EmptyBitboard},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.expected, PassedPawnSpan(test.color, test.square))
+		})
+	}
+
+	for _, test := range [...]struct {
+		name     string
+		color    Color
+		square   Square
+		expected Bitboard
+	}{
+		{"king-zone-white", White, G1, squares(F1, G1, H1, F2, G2, H2, F3, G3, H3)},
+		{"king-zone-black", Black, E8, squares(D8, E8, F8, D7, E7, F7, D6, E6, F6)},
+		{"king-zone-center", White, D4, squares(C3, D3, E3, C4, D4, E4, C5, D5, E5, C6, D6, E6)},
+		{"king-zone-far-edge", White, A8, squares(A8, B8, A7, B7)},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.expected, KingZone(test.color, test.square))
+		})
+	}
+}
+
 // Bitboard square iteration is performance critical. It needs to be fast
 // and zero-alloc.
 //
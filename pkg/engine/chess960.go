@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// This file provides functions for creating Chess960 (Fischer Random)
+// starting positions.
+
+var Chess960IndexError = errors.New("Chess960 position index out of range")
+
+// Chess960Positions is the number of distinct Chess960 starting positions.
+const Chess960Positions = 960
+
+// Chess960StandardIndex is the index of the standard chess starting position
+// in the Scharnagl numbering.
+const Chess960StandardIndex = 518
+
+// The placements of the two knights on the five squares that are left empty
+// once the bishops and queen have been placed, in Scharnagl order.
+var chess960KnightTable = [10][2]int{
+	{0, 1}, {0, 2}, {0, 3}, {0, 4},
+	{1, 2}, {1, 3}, {1, 4},
+	{2, 3}, {2, 4},
+	{3, 4},
+}
+
+// chess960BackRank returns the pieces on the back rank of the Chess960
+// starting position with the given index, from the a-file to the h-file,
+// using the Scharnagl numbering.
+func chess960BackRank(n int) ([8]PieceKind, error) {
+	var rank [8]PieceKind
+	if n < 0 || n >= Chess960Positions {
+		return rank, fmt.Errorf("%w: %d", Chess960IndexError, n)
+	}
+
+	var placed [8]bool
+	place := func(file int, kind PieceKind) {
+		rank[file] = kind
+		placed[file] = true
+	}
+
+	// emptyFiles returns the files that don't have a piece on them yet.
+	emptyFiles := func() []int {
+		var files []int
+		for file := range placed {
+			if !placed[file] {
+				files = append(files, file)
+			}
+		}
+
+		return files
+	}
+
+	// the light-squared bishop goes on b, d, f or h and the dark-squared
+	// bishop on a, c, e or g.
+	place(2*(n%4)+1, Bishop)
+	n /= 4
+	place(2*(n%4), Bishop)
+	n /= 4
+
+	// the queen goes on one of the six remaining squares...
+	place(emptyFiles()[n%6], Queen)
+	n /= 6
+
+	// ...the knights on two of the remaining five...
+	files := emptyFiles()
+	place(files[chess960KnightTable[n][0]], Knight)
+	place(files[chess960KnightTable[n][1]], Knight)
+
+	// ...and the king goes between the rooks on the last three.
+	files = emptyFiles()
+	place(files[0], Rook)
+	place(files[1], King)
+	place(files[2], Rook)
+	return rank, nil
+}
+
+// MakeChess960Position creates the Chess960 starting position with the given
+// index in the Scharnagl numbering, from 0 to 959. Index 518 is the standard
+// chess starting position.
+func MakeChess960Position(n int) (*Position, error) {
+	return MakeDoubleChess960Position(n, n)
+}
+
+// MakeDoubleChess960Position creates a "double Fischer random" starting
+// position, where White's and Black's back ranks are chosen independently by
+// the given Chess960 indices.
+func MakeDoubleChess960Position(white, black int) (*Position, error) {
+	whiteRank, err := chess960BackRank(white)
+	if err != nil {
+		return nil, err
+	}
+
+	blackRank, err := chess960BackRank(black)
+	if err != nil {
+		return nil, err
+	}
+
+	// the castling field is written in Shredder-FEN, naming the file of
+	// each rook, since the rooks are the only pieces each side can castle
+	// with.
+	backRank := func(rank [8]PieceKind, color Color) (string, string) {
+		var pieces, castles strings.Builder
+		for file, kind := range rank {
+			pieces.WriteString(MakePiece(kind, color).String())
+			if kind == Rook {
+				castles.WriteString(File(file).String())
+			}
+		}
+
+		if color == White {
+			return pieces.String(), strings.ToUpper(castles.String())
+		}
+
+		return pieces.String(), castles.String()
+	}
+
+	whitePieces, whiteCastles := backRank(whiteRank, White)
+	blackPieces, blackCastles := backRank(blackRank, Black)
+	fen := fmt.Sprintf("%s/pppppppp/8/8/8/8/PPPPPPPP/%s w %s%s - 0 1",
+		blackPieces, whitePieces, whiteCastles, blackCastles)
+	return MakePositionFromFen(fen, FenChess960())
+}
@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var chess960Tests = [...]struct {
+	index int
+	fen   string
+}{
+	{0, "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1"},
+	{518, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"},
+	{959, "rkrnnqbb/pppppppp/8/8/8/8/PPPPPPPP/RKRNNQBB w KQkq - 0 1"},
+}
+
+func TestMakeChess960Position(t *testing.T) {
+	t.Parallel()
+	for _, test := range chess960Tests {
+		test := test
+		t.Run(fmt.Sprintf("position-%d", test.index), func(tt *testing.T) {
+			pos, err := MakeChess960Position(test.index)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.True(tt, pos.IsChess960())
+			assert.Equal(tt, test.fen, pos.AsFen())
+			assert.NoError(tt, pos.Validate())
+		})
+	}
+
+	t.Run("all-distinct-and-valid", func(tt *testing.T) {
+		seen := make(map[string]int)
+		for n := 0; n < Chess960Positions; n++ {
+			pos, err := MakeChess960Position(n)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			fen := pos.AsShredderFen()
+			if other, ok := seen[fen]; ok {
+				tt.Fatalf("positions %d and %d are both %s", other, n, fen)
+			}
+
+			seen[fen] = n
+			assert.NoError(tt, pos.Validate(), "position %d", n)
+		}
+	})
+
+	t.Run("out-of-range", func(tt *testing.T) {
+		_, err := MakeChess960Position(Chess960Positions)
+		assert.True(tt, errors.Is(err, Chess960IndexError))
+
+		_, err = MakeDoubleChess960Position(0, -1)
+		assert.True(tt, errors.Is(err, Chess960IndexError))
+	})
+}
+
+func TestMakeDoubleChess960Position(t *testing.T) {
+	t.Parallel()
+	pos, err := MakeDoubleChess960Position(Chess960StandardIndex, 0)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", pos.AsFen())
+	assert.Equal(t, "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w HAhf - 0 1", pos.AsShredderFen())
+	assert.Equal(t, F8, pos.QueensideCastleRook(Black))
+	assert.Equal(t, H8, pos.KingsideCastleRook(Black))
+}
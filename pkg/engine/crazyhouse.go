@@ -0,0 +1,82 @@
+package engine
+
+// Crazyhouse is the variant in which captured pieces go into the captor's
+// pocket, and instead of moving a piece on the board, a player can drop a
+// piece from their pocket onto any empty square. Pawns can't be dropped on
+// the first or eighth ranks. Pieces that were promoted from pawns go back to
+// being pawns when they're captured.
+//
+// Pockets are written in FEN in square brackets at the end of the board
+// field, e.g. "[Nppq]", and promoted pieces are followed by a "~".
+var Crazyhouse Variant = crazyhouse{}
+
+type crazyhouse struct {
+	standard
+}
+
+func (crazyhouse) Name() string {
+	return "crazyhouse"
+}
+
+func (crazyhouse) PseudolegalMoves(pos *Position, list *MoveList) {
+	generatePseudolegalMoves(pos, list)
+	generateDropMoves(pos, list)
+}
+
+// hasPockets returns whether or not captured pieces go into pockets in this
+// position's variant, from which they can be dropped back onto the board.
+func (p *Position) hasPockets() bool {
+	return p.variant == Crazyhouse
+}
+
+// pocketCapture puts the piece on the given square, which is about to be
+// captured, into the pocket of the side to move. Promoted pieces go into the
+// pocket as pawns.
+func (p *Position) pocketCapture(square Square) {
+	captured := p.pieceAtOrPanic(square)
+	kind := captured.kind
+	if p.promoted.Test(square) {
+		kind = Pawn
+		p.promoted.Unset(square)
+	}
+
+	p.pockets[p.sideToMove][kind]++
+}
+
+// isDropPseudoLegal returns whether or not the given drop move can be played
+// in this position: the piece has to be in the side to move's pocket and the
+// destination square has to be empty, and pawns can't be dropped on the first
+// or eighth ranks.
+func (p *Position) isDropPseudoLegal(mov Move) bool {
+	kind := mov.DropPiece()
+	if kind >= King || p.pockets[p.sideToMove][kind] == 0 {
+		return false
+	}
+
+	if _, hasPiece := p.PieceAt(mov.Destination()); hasPiece {
+		return false
+	}
+
+	rank := mov.Destination().Rank()
+	return kind != Pawn || (rank != Rank1 && rank != Rank8)
+}
+
+// pocketString returns the contents of both pockets as they are written in
+// FEN, White's first, with the most valuable pieces first.
+func (p *Position) pocketString() string {
+	var runes []rune
+	for _, color := range [...]Color{White, Black} {
+		for kind := Queen; ; kind-- {
+			piece := []rune(MakePiece(kind, color).String())[0]
+			for i := uint8(0); i < p.pockets[color][kind]; i++ {
+				runes = append(runes, piece)
+			}
+
+			if kind == Pawn {
+				break
+			}
+		}
+	}
+
+	return string(runes)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -21,10 +22,98 @@ var FenInvalidCastleStatusError = errors.New("invalid castle status in FEN strin
 var FenInvalidEnPassantError = errors.New("invalid en passant in FEN string")
 var FenInvalidHalfmoveError = errors.New("invalid halfmove in FEN")
 var FenInvalidFullmoveError = errors.New("invalid fullmove in FEN")
+var FenUnexpectedCharacterError = errors.New("unexpected character in FEN string")
+var FenDoubledPieceError = errors.New("more than one piece on a square in FEN string")
+var FenInvalidPocketError = errors.New("invalid pocket in FEN string")
+
+// A FenField is one of the space-separated fields of a FEN string.
+type FenField int
+
+const (
+	FenFieldBoard FenField = iota
+	FenFieldSideToMove
+	FenFieldCastling
+	FenFieldEnPassant
+	FenFieldVariant
+	FenFieldHalfmove
+	FenFieldFullmove
+)
+
+func (f FenField) String() string {
+	switch f {
+	case FenFieldBoard:
+		return "board"
+	case FenFieldSideToMove:
+		return "side to move"
+	case FenFieldCastling:
+		return "castling"
+	case FenFieldEnPassant:
+		return "en passant"
+	case FenFieldVariant:
+		return "variant"
+	case FenFieldHalfmove:
+		return "halfmove clock"
+	case FenFieldFullmove:
+		return "fullmove clock"
+	}
+
+	panic("unknown FenField")
+}
+
+// A FenError is returned when a FEN string fails to parse. It records where
+// in the string the problem was found, and wraps one of the Fen*Error
+// sentinels above so that callers can use errors.Is to find out what the
+// problem was.
+type FenError struct {
+	// The FEN string that failed to parse.
+	Fen string
+
+	// The field of the FEN string that contains the error.
+	Field FenField
+
+	// The offset, in runes, of the start of the offending text.
+	Offset int
+
+	// The offending text, or the empty string if the FEN string ended
+	// early.
+	Text string
+
+	// The sentinel error describing the problem.
+	Err error
+}
+
+func (e *FenError) Error() string {
+	if e.Text == "" {
+		return fmt.Sprintf("%s (%s field, at end of input)", e.Err.Error(), e.Field)
+	}
+
+	return fmt.Sprintf("%s (%s field, offset %d: `%s`)", e.Err.Error(), e.Field, e.Offset, e.Text)
+}
+
+func (e *FenError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostic renders this error as the FEN string that failed to parse, with
+// a caret pointing at the offending text underneath it, followed by the error
+// message.
+func (e *FenError) Diagnostic() string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, e.Fen)
+	fmt.Fprint(buf, strings.Repeat(" ", e.Offset))
+	width := utf8.RuneCountInString(e.Text)
+	if width == 0 {
+		width = 1
+	}
+
+	fmt.Fprintln(buf, strings.Repeat("^", width))
+	fmt.Fprintln(buf, e.Error())
+	return buf.String()
+}
 
 // MakeDefaultPosition creates a default chess position.
 func MakeDefaultPosition() *Position {
-	pos, err := MakePositionFromFen("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 2 1")
+	pos, err := MakePositionFromFen("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1")
 	if err != nil {
 		panic(err)
 	}
@@ -32,12 +121,73 @@ func MakeDefaultPosition() *Position {
 	return pos
 }
 
+// A FenOption changes the way that MakePositionFromFen parses a FEN string.
+type FenOption func(*fenOptions)
+
+type fenOptions struct {
+	validate bool
+	lenient  bool
+	chess960 bool
+	variant  Variant
+}
+
+// FenValidate instructs MakePositionFromFen to reject positions that are
+// well-formed FEN but not legal chess positions, returning the
+// *ValidationError produced by Position.Validate.
+func FenValidate() FenOption {
+	return func(opts *fenOptions) {
+		opts.validate = true
+	}
+}
+
+// FenLenient instructs MakePositionFromFen to accept the abbreviated FEN
+// strings that are common in the wild. Fields are separated by any amount of
+// whitespace and any trailing fields may be omitted, in which case they
+// default to "w - - 0 1".
+func FenLenient() FenOption {
+	return func(opts *fenOptions) {
+		opts.lenient = true
+	}
+}
+
+// FenChess960 instructs MakePositionFromFen to treat the position as a
+// Chess960 position, in which the king and rooks may start on any file. This
+// is implied if the castling field uses Shredder-FEN or X-FEN file letters.
+func FenChess960() FenOption {
+	return func(opts *fenOptions) {
+		opts.chess960 = true
+	}
+}
+
+// FenVariant instructs MakePositionFromFen to create a position that is
+// played by the rules of the given variant. If the variant is a
+// FenExtension, the FEN string must contain its extra field after the
+// en-passant square.
+func FenVariant(variant Variant) FenOption {
+	return func(opts *fenOptions) {
+		opts.variant = variant
+	}
+}
+
 // MakePositionFromFen parses a FEN string and produces a Position
 // from it. If the string is not valid FEN, an error is returned.
-func MakePositionFromFen(fen string) (*Position, error) {
+func MakePositionFromFen(fen string, opts ...FenOption) (*Position, error) {
+	fenOpts := fenOptions{variant: Standard}
+	for _, opt := range opts {
+		opt(&fenOpts)
+	}
+
 	position := MakeEmptyPosition()
+	position.chess960 = fenOpts.chess960
+	position.variant = fenOpts.variant
+	if fenOpts.lenient {
+		fen = strings.TrimSpace(fen)
+		position.fullmoveClock = 1
+	}
+
 	runes := []rune(fen)
 	index := 0
+	field := FenFieldBoard
 
 	// helper functions for parsing a list of runes
 	peek := func() rune {
@@ -52,17 +202,61 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		index++
 	}
 
+	// fail produces a FenError for the text between start and the current
+	// index, or for the current rune if the two are the same.
+	fail := func(start int, err error) error {
+		end := index
+		if end == start {
+			end++
+		}
+
+		if end > len(runes) {
+			end = len(runes)
+		}
+
+		text := ""
+		if start < end {
+			text = string(runes[start:end])
+		}
+
+		return &FenError{fen, field, start, text, err}
+	}
+
 	eat := func(r rune) error {
 		peeked := peek()
 		switch peeked {
 		case utf8.RuneError:
-			return FenEndOfFileError
+			return fail(index, FenEndOfFileError)
 		case r:
 			advance()
 			return nil
 		}
 
-		return fmt.Errorf("expected character `%c`, got `%c`", r, peeked)
+		return fail(index, FenUnexpectedCharacterError)
+	}
+
+	// eatSeparator eats the whitespace between two fields, which in lenient
+	// mode may be any run of spaces and tabs.
+	eatSeparator := func() error {
+		if err := eat(' '); err != nil {
+			if !fenOpts.lenient || peek() != '\t' {
+				return err
+			}
+
+			advance()
+		}
+
+		for fenOpts.lenient && (peek() == ' ' || peek() == '\t') {
+			advance()
+		}
+
+		return nil
+	}
+
+	// omitted reports whether the remaining fields have been left off,
+	// which is only permitted in lenient mode.
+	omitted := func() bool {
+		return fenOpts.lenient && index >= len(runes)
 	}
 
 	// helper functions for parsing particular productions of the FEN grammar
@@ -83,13 +277,13 @@ func MakePositionFromFen(fen string) (*Position, error) {
 					// entry must be a digit 1-8 instructing us to skip the
 					// next 1-8 squares.
 					if entry < '1' || entry > '8' {
-						return FenInvalidDigitError
+						return fail(index, FenInvalidDigitError)
 					}
 
 					value := int(entry - 48)
 					file += File(value)
 					if file > 8 {
-						return FenSumToEightError
+						return fail(index, FenSumToEightError)
 					}
 
 					advance()
@@ -99,15 +293,23 @@ func MakePositionFromFen(fen string) (*Position, error) {
 				// if it's not a digit, this character represents a piece.
 				piece, err := MakePieceFromRune(entry)
 				if err != nil {
-					return FenUnknownRuneOrEofError
+					return fail(index, FenUnknownRuneOrEofError)
 				}
 
 				square := MakeSquare(rank, file)
 				if err := position.AddPiece(square, piece); err != nil {
-					panic("unexpected double-add of piece when parsing FEN")
+					return fail(index, FenDoubledPieceError)
 				}
 
 				advance()
+
+				// in Crazyhouse, pieces that were promoted from pawns
+				// are followed by a tilde.
+				if position.hasPockets() && peek() == '~' {
+					position.promoted.Set(square)
+					advance()
+				}
+
 				file++
 			}
 
@@ -125,8 +327,53 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		return nil
 	}
 
+	// Crazyhouse positions have the pockets at the end of the board field,
+	// either in square brackets or, in older FEN strings, as a ninth rank.
+	// lenient mode allows the pockets to be left off entirely.
+	eatPocket := func() error {
+		if !position.hasPockets() {
+			return nil
+		}
+
+		var closing rune
+		switch peek() {
+		case '[':
+			closing = ']'
+		case '/':
+			closing = ' '
+		default:
+			if fenOpts.lenient {
+				return nil
+			}
+
+			return fail(index, FenInvalidPocketError)
+		}
+
+		advance()
+		for peek() != closing {
+			if closing == ' ' && (peek() == '\t' || peek() == utf8.RuneError) {
+				break
+			}
+
+			piece, err := MakePieceFromRune(peek())
+			if err != nil || piece.kind == King {
+				return fail(index, FenInvalidPocketError)
+			}
+
+			position.pockets[piece.color][piece.kind]++
+			advance()
+		}
+
+		if closing == ']' {
+			advance()
+		}
+
+		return nil
+	}
+
 	eatSideToMove := func() error {
-		if err := eat(' '); err != nil {
+		field = FenFieldSideToMove
+		if err := eatSeparator(); err != nil {
 			return err
 		}
 
@@ -136,7 +383,7 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		case 'b':
 			position.sideToMove = Black
 		default:
-			return FenInvalidSideToMoveError
+			return fail(index, FenInvalidSideToMoveError)
 		}
 
 		advance()
@@ -144,7 +391,8 @@ func MakePositionFromFen(fen string) (*Position, error) {
 	}
 
 	eatCastleStatus := func() error {
-		if err := eat(' '); err != nil {
+		field = FenFieldCastling
+		if err := eatSeparator(); err != nil {
 			return err
 		}
 
@@ -153,20 +401,47 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		} else {
 			// k, q, K, or Q can appear in any order here and indicate
 			// which color and side is able to castle from this position.
+			//
+			// Chess960 positions use either X-FEN, where K and Q refer
+			// to the outermost rook on that side of the king and the
+			// file of the rook is given instead if that is ambiguous, or
+			// Shredder-FEN, which always gives the file of the rook.
 			for i := 0; i < 4; i++ {
-				switch peek() {
-				case 'K':
-					position.castleStatus |= whiteOO
-				case 'k':
-					position.castleStatus |= blackOO
-				case 'Q':
-					position.castleStatus |= whiteOOO
-				case 'q':
-					position.castleStatus |= blackOOO
-				case ' ':
+				entry := peek()
+				switch {
+				case entry == ' ':
 					return nil
+				case entry == '\t' || entry == utf8.RuneError:
+					if fenOpts.lenient {
+						return nil
+					}
+
+					return fail(index, FenInvalidCastleStatusError)
+				case entry == 'K' || entry == 'Q' || entry == 'k' || entry == 'q':
+					color := White
+					if unicode.IsLower(entry) {
+						color = Black
+					}
+
+					kingside := unicode.ToUpper(entry) == 'K'
+					position.setCastleRights(color, kingside, position.outermostRook(color, kingside))
+				case (entry >= 'A' && entry <= 'H') || (entry >= 'a' && entry <= 'h'):
+					color := White
+					if unicode.IsLower(entry) {
+						color = Black
+					}
+
+					file, _ := MakeFileFromRune(unicode.ToLower(entry))
+					rook := MakeSquare(backRank(color), file)
+					king, ok := position.castleKing(color)
+					if !ok || !position.Rooks(color).Test(rook) {
+						return fail(index, FenInvalidCastleStatusError)
+					}
+
+					position.setCastleRights(color, file > king.File(), rook)
+					position.chess960 = true
 				default:
-					return FenInvalidCastleStatusError
+					return fail(index, FenInvalidCastleStatusError)
 				}
 
 				advance()
@@ -177,7 +452,8 @@ func MakePositionFromFen(fen string) (*Position, error) {
 	}
 
 	eatEnPassant := func() error {
-		if err := eat(' '); err != nil {
+		field = FenFieldEnPassant
+		if err := eatSeparator(); err != nil {
 			return err
 		}
 
@@ -185,15 +461,17 @@ func MakePositionFromFen(fen string) (*Position, error) {
 			position.enPassantSquare = InvalidSquare
 			advance()
 		} else {
+			start := index
 			epFile, err := MakeFileFromRune(peek())
 			if err != nil {
-				return FenInvalidEnPassantError
+				return fail(start, FenInvalidEnPassantError)
 			}
 
 			advance()
 			epRank, err := MakeRankFromRune(peek())
 			if err != nil {
-				return FenInvalidEnPassantError
+				index++
+				return fail(start, FenInvalidEnPassantError)
 			}
 
 			advance()
@@ -203,17 +481,44 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		return nil
 	}
 
+	// variants that keep extra state in FEN write it as an extra field
+	// after the en-passant square.
+	eatVariantField := func() error {
+		extension, ok := fenOpts.variant.(FenExtension)
+		if !ok {
+			return nil
+		}
+
+		field = FenFieldVariant
+		if err := eatSeparator(); err != nil {
+			return err
+		}
+
+		start := index
+		for index < len(runes) && runes[index] != ' ' && runes[index] != '\t' {
+			advance()
+		}
+
+		if err := extension.ParseFenField(position, string(runes[start:index])); err != nil {
+			return fail(start, err)
+		}
+
+		return nil
+	}
+
 	eatHalfmove := func() error {
-		if err := eat(' '); err != nil {
+		field = FenFieldHalfmove
+		if err := eatSeparator(); err != nil {
 			return err
 		}
 
+		start := index
 		var buf []rune
 		for {
 			next := peek()
 			if !unicode.IsDigit(next) {
 				if len(buf) == 0 {
-					return FenInvalidHalfmoveError
+					return fail(start, FenInvalidHalfmoveError)
 				}
 
 				break
@@ -225,7 +530,7 @@ func MakePositionFromFen(fen string) (*Position, error) {
 
 		parsed, err := strconv.ParseUint(string(buf), 10, 32)
 		if err != nil {
-			return FenInvalidHalfmoveError
+			return fail(start, FenInvalidHalfmoveError)
 		}
 
 		position.halfmoveClock = uint32(parsed)
@@ -233,10 +538,12 @@ func MakePositionFromFen(fen string) (*Position, error) {
 	}
 
 	eatFullmove := func() error {
-		if err := eat(' '); err != nil {
+		field = FenFieldFullmove
+		if err := eatSeparator(); err != nil {
 			return err
 		}
 
+		start := index
 		var buf []rune
 		for {
 			if index >= len(runes) {
@@ -245,7 +552,8 @@ func MakePositionFromFen(fen string) (*Position, error) {
 
 			next := peek()
 			if !unicode.IsDigit(next) {
-				return FenInvalidFullmoveError
+				index++
+				return fail(start, FenInvalidFullmoveError)
 			}
 
 			buf = append(buf, next)
@@ -254,7 +562,7 @@ func MakePositionFromFen(fen string) (*Position, error) {
 
 		parsed, err := strconv.ParseUint(string(buf), 10, 32)
 		if err != nil {
-			return FenInvalidFullmoveError
+			return fail(start, FenInvalidFullmoveError)
 		}
 
 		position.fullmoveClock = uint32(parsed)
@@ -266,32 +574,130 @@ func MakePositionFromFen(fen string) (*Position, error) {
 		return nil, err
 	}
 
-	if err := eatSideToMove(); err != nil {
+	if err := eatPocket(); err != nil {
 		return nil, err
 	}
 
-	if err := eatCastleStatus(); err != nil {
-		return nil, err
-	}
-
-	if err := eatEnPassant(); err != nil {
-		return nil, err
-	}
+	for _, eatField := range []func() error{eatSideToMove, eatCastleStatus, eatEnPassant, eatVariantField, eatHalfmove, eatFullmove} {
+		if omitted() {
+			break
+		}
 
-	if err := eatHalfmove(); err != nil {
-		return nil, err
+		if err := eatField(); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := eatFullmove(); err != nil {
-		return nil, err
+	if fenOpts.validate {
+		if err := position.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
 	return position, nil
 }
 
 // AsFen retuns a string representation of this position in FEN
-// notation.
+// notation. The castling field of Chess960 positions is written in X-FEN.
 func (pos *Position) AsFen() string {
+	return pos.asFen(false, false)
+}
+
+// AsShredderFen returns a string representation of this position in
+// Shredder-FEN notation, which is the same as FEN except that the castling
+// field gives the files of the castling rooks rather than KQkq.
+func (pos *Position) AsShredderFen() string {
+	return pos.asFen(false, true)
+}
+
+// AsCanonicalFen returns the canonical FEN representation of this position,
+// which differs from AsFen only in that the en-passant square is written
+// only if an en-passant capture is legal. Two positions that are the same for
+// the purposes of move generation always have the same canonical FEN.
+func (pos *Position) AsCanonicalFen() string {
+	return pos.asFen(true, false)
+}
+
+// enPassantCapturable returns whether or not the side to move has a legal
+// en-passant capture.
+func (pos *Position) enPassantCapturable() bool {
+	if !pos.HasEnPassantSquare() {
+		return false
+	}
+
+	for _, mov := range pos.LegalMoves() {
+		if mov.IsEnPassant() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setCastleRights gives the given color the right to castle on the given side
+// with the rook on the given square.
+func (pos *Position) setCastleRights(color Color, kingside bool, rook Square) {
+	pos.castleStatus |= castleFlag(color, kingside)
+	pos.castleRooks[color][castleSide(kingside)] = rook
+}
+
+// outermostRook returns the square of the given color's rook on its back
+// rank that is furthest from the king on the given side, which is the rook
+// that K or Q refers to in X-FEN. If there's no such rook, it returns the
+// corner square, which is where the rook would be in standard chess.
+func (pos *Position) outermostRook(color Color, kingside bool) Square {
+	rank := backRank(color)
+	corner := MakeSquare(rank, FileH)
+	if !kingside {
+		corner = MakeSquare(rank, FileA)
+	}
+
+	king, ok := pos.castleKing(color)
+	if !ok {
+		return corner
+	}
+
+	// search inwards from the corner towards the king.
+	for i := 0; i < 8; i++ {
+		file := FileH - File(i)
+		if !kingside {
+			file = FileA + File(i)
+		}
+
+		square := MakeSquare(rank, file)
+		if square == king {
+			break
+		}
+
+		if pos.Rooks(color).Test(square) {
+			return square
+		}
+	}
+
+	return corner
+}
+
+// castleRune returns the rune used in the castling field of a FEN string for
+// the given color's right to castle on the given side.
+func (pos *Position) castleRune(color Color, kingside, shredder bool) rune {
+	rook := pos.castleRooks[color][castleSide(kingside)]
+	var r rune
+	if shredder || pos.outermostRook(color, kingside) != rook {
+		r = []rune(rook.File().String())[0]
+	} else if kingside {
+		r = 'k'
+	} else {
+		r = 'q'
+	}
+
+	if color == White {
+		return unicode.ToUpper(r)
+	}
+
+	return unicode.ToLower(r)
+}
+
+func (pos *Position) asFen(canonical, shredder bool) string {
 	buf := new(bytes.Buffer)
 	for rank := Rank8; ; rank-- {
 		emptySquares := 0
@@ -304,6 +710,10 @@ func (pos *Position) AsFen() string {
 				}
 
 				fmt.Fprintf(buf, "%s", piece.String())
+				if pos.hasPockets() && pos.promoted.Test(square) {
+					fmt.Fprint(buf, "~")
+				}
+
 				emptySquares = 0
 			} else {
 				emptySquares++
@@ -321,6 +731,10 @@ func (pos *Position) AsFen() string {
 		fmt.Fprint(buf, "/")
 	}
 
+	if pos.hasPockets() {
+		fmt.Fprintf(buf, "[%s]", pos.pocketString())
+	}
+
 	fmt.Fprint(buf, " ")
 	if pos.SideToMove() == White {
 		fmt.Fprint(buf, "w")
@@ -330,24 +744,16 @@ func (pos *Position) AsFen() string {
 
 	fmt.Fprint(buf, " ")
 	someoneCanCastle := false
-	if pos.CanCastleKingside(White) {
-		fmt.Fprint(buf, "K")
-		someoneCanCastle = true
-	}
-
-	if pos.CanCastleQueenside(White) {
-		fmt.Fprint(buf, "Q")
-		someoneCanCastle = true
-	}
-
-	if pos.CanCastleKingside(Black) {
-		fmt.Fprint(buf, "k")
-		someoneCanCastle = true
-	}
+	for _, color := range [...]Color{White, Black} {
+		if pos.CanCastleKingside(color) {
+			fmt.Fprintf(buf, "%c", pos.castleRune(color, true, shredder))
+			someoneCanCastle = true
+		}
 
-	if pos.CanCastleQueenside(Black) {
-		fmt.Fprint(buf, "q")
-		someoneCanCastle = true
+		if pos.CanCastleQueenside(color) {
+			fmt.Fprintf(buf, "%c", pos.castleRune(color, false, shredder))
+			someoneCanCastle = true
+		}
 	}
 
 	if !someoneCanCastle {
@@ -355,12 +761,16 @@ func (pos *Position) AsFen() string {
 	}
 
 	fmt.Fprint(buf, " ")
-	if pos.HasEnPassantSquare() {
+	if pos.HasEnPassantSquare() && (!canonical || pos.enPassantCapturable()) {
 		fmt.Fprintf(buf, "%s", pos.EnPassantSquare())
 	} else {
 		fmt.Fprint(buf, "-")
 	}
 
+	if extension, ok := pos.variant.(FenExtension); ok {
+		fmt.Fprintf(buf, " %s", extension.FenField(pos))
+	}
+
 	fmt.Fprint(buf, " ")
 	fmt.Fprintf(buf, "%d %d", pos.HalfmoveClock(), pos.FullmoveClock())
 	return buf.String()
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -149,84 +150,84 @@ func TestFen(t *testing.T) {
 	t.Run("empty", func(tt *testing.T) {
 		_, err := MakePositionFromFen("")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenUnknownRuneOrEofError, err)
+			assert.True(tt, errors.Is(err, FenUnknownRuneOrEofError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("unknown-piece", func(tt *testing.T) {
 		_, err := MakePositionFromFen("z7/8/8/8/8/8/8/8 w - - 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenUnknownRuneOrEofError, err)
+			assert.True(tt, errors.Is(err, FenUnknownRuneOrEofError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("invalid-digit", func(tt *testing.T) {
 		_, err := MakePositionFromFen("9/8/8/8/8/8/8/8 w - - 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidDigitError, err)
+			assert.True(tt, errors.Is(err, FenInvalidDigitError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("not-sum-to-8", func(tt *testing.T) {
 		_, err := MakePositionFromFen("pppp5/8/8/8/8/8/8/8 w - - 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenSumToEightError, err)
+			assert.True(tt, errors.Is(err, FenSumToEightError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("bad-side-to-move", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 c - - 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidSideToMoveError, err)
+			assert.True(tt, errors.Is(err, FenInvalidSideToMoveError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("bad-castle-status", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w a - 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidCastleStatusError, err)
+			assert.True(tt, errors.Is(err, FenInvalidCastleStatusError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("bad-en-passant", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - 88 0 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidEnPassantError, err)
+			assert.True(tt, errors.Is(err, FenInvalidEnPassantError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("empty-halfmove", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - q 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidHalfmoveError, err)
+			assert.True(tt, errors.Is(err, FenInvalidHalfmoveError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("invalid-halfmove", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 4294967296 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidHalfmoveError, err)
+			assert.True(tt, errors.Is(err, FenInvalidHalfmoveError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("empty-fullmove", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 0 q")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidFullmoveError, err)
+			assert.True(tt, errors.Is(err, FenInvalidFullmoveError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("fullmove-early-end", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 0")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenEndOfFileError, err)
+			assert.True(tt, errors.Is(err, FenEndOfFileError), "unexpected error: %s", err)
 		}
 	})
 
 	t.Run("invalid-fullmove", func(tt *testing.T) {
 		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 0 4294967296")
 		if assert.Error(tt, err) {
-			assert.Equal(tt, FenInvalidFullmoveError, err)
+			assert.True(tt, errors.Is(err, FenInvalidFullmoveError), "unexpected error: %s", err)
 		}
 	})
 
@@ -243,6 +244,74 @@ func TestFen(t *testing.T) {
 	})
 }
 
+func TestFenErrors(t *testing.T) {
+	t.Parallel()
+	t.Run("field-and-offset", func(tt *testing.T) {
+		fen := "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KXkq - 0 1"
+		_, err := MakePositionFromFen(fen)
+		var fenErr *FenError
+		if !assert.True(tt, errors.As(err, &fenErr)) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, fen, fenErr.Fen)
+		assert.Equal(tt, FenFieldCastling, fenErr.Field)
+		assert.Equal(tt, 47, fenErr.Offset)
+		assert.Equal(tt, "X", fenErr.Text)
+		assert.Equal(tt, FenInvalidCastleStatusError, fenErr.Err)
+	})
+
+	t.Run("board-offset", func(tt *testing.T) {
+		_, err := MakePositionFromFen("rnbqkbnr/ppp?pppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1")
+		var fenErr *FenError
+		if !assert.True(tt, errors.As(err, &fenErr)) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, FenFieldBoard, fenErr.Field)
+		assert.Equal(tt, 12, fenErr.Offset)
+		assert.Equal(tt, "?", fenErr.Text)
+	})
+
+	t.Run("clock-text", func(tt *testing.T) {
+		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 0 12x")
+		var fenErr *FenError
+		if !assert.True(tt, errors.As(err, &fenErr)) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, FenFieldFullmove, fenErr.Field)
+		assert.Equal(tt, 24, fenErr.Offset)
+		assert.Equal(tt, "12x", fenErr.Text)
+	})
+
+	t.Run("end-of-input", func(tt *testing.T) {
+		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w")
+		var fenErr *FenError
+		if !assert.True(tt, errors.As(err, &fenErr)) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, FenFieldCastling, fenErr.Field)
+		assert.Equal(tt, 17, fenErr.Offset)
+		assert.Equal(tt, "", fenErr.Text)
+		assert.True(tt, errors.Is(err, FenEndOfFileError))
+	})
+
+	t.Run("diagnostic", func(tt *testing.T) {
+		_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - a9 0 1")
+		var fenErr *FenError
+		if !assert.True(tt, errors.As(err, &fenErr)) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "8/8/8/8/8/8/8/8 w - a9 0 1\n"+
+			"                    ^^\n"+
+			"invalid en passant in FEN string (en passant field, offset 20: `a9`)\n",
+			fenErr.Diagnostic())
+	})
+}
+
 var fenRoundtripTests = [...]string{
 	"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
 	"8/6Q1/5b2/4r3/3k4/2R5/1N6/P7 w - - 0 1",
@@ -261,3 +330,131 @@ func TestFenRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+var fenLenientTests = [...]struct {
+	fen      string
+	expected string
+}{
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq -", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"},
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3 1"},
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"},
+	{"8/8/8/8/8/8/8/K1k5 b", "8/8/8/8/8/8/8/K1k5 b - - 0 1"},
+	{"8/8/8/8/8/8/8/K1k5", "8/8/8/8/8/8/8/K1k5 w - - 0 1"},
+	{"  8/8/8/8/8/8/8/K1k5   b\t-  -  7 40 \n", "8/8/8/8/8/8/8/K1k5 b - - 7 40"},
+}
+
+func TestFenLenient(t *testing.T) {
+	t.Parallel()
+	for _, test := range fenLenientTests {
+		test := test
+		t.Run(test.fen, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, FenLenient())
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, test.expected, pos.AsFen())
+
+			// none of these are acceptable without the option.
+			_, err = MakePositionFromFen(test.fen)
+			assert.Error(tt, err)
+		})
+	}
+
+	t.Run("still-rejects-bad-fields", func(tt *testing.T) {
+		_, err := MakePositionFromFen("8/8/8/8/8/8/8/K1k5 w KX", FenLenient())
+		assert.True(tt, errors.Is(err, FenInvalidCastleStatusError), "unexpected error: %s", err)
+	})
+}
+
+var canonicalFenTests = [...]struct {
+	fen       string
+	canonical string
+}{
+	// the double push can't be captured at all.
+	{"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1", "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1"},
+	// the double push can be captured.
+	{"4k3/8/8/8/3pP3/8/8/4K3 b - e3 0 1", "4k3/8/8/8/3pP3/8/8/4K3 b - e3 0 1"},
+	// the capturing pawn is pinned against its king.
+	{"8/8/8/8/k2pP2R/8/8/4K3 b - e3 0 1", "8/8/8/8/k2pP2R/8/8/4K3 b - - 0 1"},
+}
+
+func TestCanonicalFen(t *testing.T) {
+	t.Parallel()
+	for _, test := range canonicalFenTests {
+		test := test
+		t.Run(test.fen, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, test.fen, pos.AsFen())
+			assert.Equal(tt, test.canonical, pos.AsCanonicalFen())
+		})
+	}
+
+	t.Run("default-position", func(tt *testing.T) {
+		assert.Equal(tt, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", MakeDefaultPosition().AsFen())
+	})
+}
+
+var chess960FenTests = [...]struct {
+	fen      string
+	xfen     string
+	shredder string
+}{
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w HAha - 0 1", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w HAha - 0 1"},
+	{"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9", "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w KQkq - 2 9", "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9"},
+	// the inner of two rooks on the same side has to be named by its file.
+	{"1r2k1rr/8/8/8/8/8/8/1R2K1RR w Gg - 0 1", "1r2k1rr/8/8/8/8/8/8/1R2K1RR w Gg - 0 1", "1r2k1rr/8/8/8/8/8/8/1R2K1RR w Gg - 0 1"},
+	{"1r2k1rr/8/8/8/8/8/8/1R2K1RR w KQkq - 0 1", "1r2k1rr/8/8/8/8/8/8/1R2K1RR w KQkq - 0 1", "1r2k1rr/8/8/8/8/8/8/1R2K1RR w HBhb - 0 1"},
+}
+
+func TestChess960Fen(t *testing.T) {
+	t.Parallel()
+	for _, test := range chess960FenTests {
+		test := test
+		t.Run(test.fen, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, FenChess960())
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.True(tt, pos.IsChess960())
+			assert.Equal(tt, test.xfen, pos.AsFen())
+			assert.Equal(tt, test.shredder, pos.AsShredderFen())
+
+			// both forms describe the same position.
+			for _, fen := range []string{test.xfen, test.shredder} {
+				other, err := MakePositionFromFen(fen, FenChess960())
+				if assert.NoError(tt, err) {
+					assert.Equal(tt, test.shredder, other.AsShredderFen())
+				}
+			}
+		})
+	}
+
+	t.Run("file-letters-imply-chess960", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("rk5r/8/8/8/8/8/8/RK5R w HAha - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.True(tt, pos.IsChess960())
+		assert.Equal(tt, H1, pos.KingsideCastleRook(White))
+		assert.Equal(tt, A8, pos.QueensideCastleRook(Black))
+
+		pos, err = MakePositionFromFen("rk5r/8/8/8/8/8/8/RK5R w KQkq - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.False(tt, pos.IsChess960())
+	})
+
+	t.Run("file-without-rook", func(tt *testing.T) {
+		_, err := MakePositionFromFen("rk5r/8/8/8/8/8/8/RK5R w G - 0 1")
+		assert.True(tt, errors.Is(err, FenInvalidCastleStatusError), "unexpected error: %s", err)
+	})
+}
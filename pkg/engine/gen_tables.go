@@ -0,0 +1,266 @@
+//go:build ignore
+
+// This program generates attack_tables.go, which holds the precomputed attack
+// tables used by the move generator, along with the other precomputed bitboard
+// masks. Run it with "go generate" from this
+// directory after changing it.
+//
+// It is deliberately self-contained, rather than using the engine's own types,
+// so that it still builds when attack_tables.go is missing or out of date.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+const output = "attack_tables.go"
+
+// the vectors of the eight directions, in the order of the engine's
+// Direction constants: north, north-east, east, south-east, south,
+// south-west, west and north-west.
+var directions = [8]struct{ rank, file int }{
+	{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1},
+}
+
+var knightJumps = [8]struct{ rank, file int }{
+	{2, -1}, {2, 1}, {1, 2}, {-1, 2}, {-2, 1}, {-2, -1}, {-1, -2}, {1, -2},
+}
+
+// bit returns the bitboard with only the square at the given rank and file
+// set, or zero if the square is off the board.
+func bit(rank, file int) uint64 {
+	if rank < 0 || rank > 7 || file < 0 || file > 7 {
+		return 0
+	}
+
+	return 1 << uint(rank*8+file)
+}
+
+// ray returns the squares that a sliding piece on the given square attacks
+// in the given direction on an empty board.
+func ray(square, direction int) uint64 {
+	var board uint64
+	rank, file := square/8, square%8
+	for {
+		rank += directions[direction].rank
+		file += directions[direction].file
+		next := bit(rank, file)
+		if next == 0 {
+			return board
+		}
+
+		board |= next
+	}
+}
+
+// pawnAttacks returns the squares that a pawn of the given color (0 for
+// white, 1 for black) on the given square attacks. Pawns on their promotion
+// rank attack nothing.
+func pawnAttacks(square, color int) uint64 {
+	rank, file := square/8, square%8
+	forward := 1
+	if color == 1 {
+		forward = -1
+	}
+
+	return bit(rank+forward, file-1) | bit(rank+forward, file+1)
+}
+
+func knightAttacks(square int) uint64 {
+	var board uint64
+	for _, jump := range knightJumps {
+		board |= bit(square/8+jump.rank, square%8+jump.file)
+	}
+
+	return board
+}
+
+func kingAttacks(square int) uint64 {
+	var board uint64
+	for _, direction := range directions {
+		board |= bit(square/8+direction.rank, square%8+direction.file)
+	}
+
+	return board
+}
+
+// forward returns the direction that pawns of the given color (0 for white, 1
+// for black) move in, as a change in rank.
+func forward(color int) int {
+	if color == 1 {
+		return -1
+	}
+
+	return 1
+}
+
+// passedPawnSpan returns the squares in front of a pawn of the given color on
+// the given square, on its own file and the adjacent ones, which must be free
+// of enemy pawns for it to be passed.
+func passedPawnSpan(square, color int) uint64 {
+	var board uint64
+	rank := square/8 + forward(color)
+	for ; rank >= 0 && rank <= 7; rank += forward(color) {
+		for file := square%8 - 1; file <= square%8+1; file++ {
+			board |= bit(rank, file)
+		}
+	}
+
+	return board
+}
+
+// kingZone returns the squares around a king of the given color on the given
+// square, along with the three squares two ranks in front of it.
+func kingZone(square, color int) uint64 {
+	rank, file := square/8, square%8
+	ahead := rank + 2*forward(color)
+	return kingAttacks(square) | bit(rank, file) | bit(ahead, file-1) | bit(ahead, file) | bit(ahead, file+1)
+}
+
+// writeColorSquareTable writes out a table indexed by color and then square.
+func writeColorSquareTable(buf *bytes.Buffer, name string, entry func(square, color int) uint64) {
+	fmt.Fprintf(buf, "var %s = [2][64]Bitboard{\n", name)
+	for color := 0; color < 2; color++ {
+		fmt.Fprint(buf, "{")
+		for square := 0; square < 64; square++ {
+			fmt.Fprintf(buf, "%#016x, ", entry(square, color))
+		}
+
+		fmt.Fprintln(buf, "},")
+	}
+
+	fmt.Fprintln(buf, "}")
+}
+
+// direction returns the index of the direction that leads from square a to
+// square b in a straight line, or -1 if they aren't on a common rank, file or
+// diagonal.
+func direction(a, b int) int {
+	for direction := range directions {
+		if ray(a, direction)&(1<<uint(b)) != 0 {
+			return direction
+		}
+	}
+
+	return -1
+}
+
+// between returns the squares strictly between squares a and b, if they are
+// on a common rank, file or diagonal.
+func between(a, b int) uint64 {
+	toward := direction(a, b)
+	if toward == -1 {
+		return 0
+	}
+
+	return ray(a, toward) & ray(b, (toward+4)%8)
+}
+
+// line returns every square on the rank, file or diagonal that squares a and
+// b share, if they share one, from one edge of the board to the other.
+func line(a, b int) uint64 {
+	toward := direction(a, b)
+	if toward == -1 {
+		return 0
+	}
+
+	return ray(a, toward) | ray(a, (toward+4)%8) | 1<<uint(a)
+}
+
+// writeSquarePairTable writes out a table indexed by two squares.
+func writeSquarePairTable(buf *bytes.Buffer, name string, entry func(a, b int) uint64) {
+	fmt.Fprintf(buf, "var %s = [64][64]Bitboard{\n", name)
+	for a := 0; a < 64; a++ {
+		fmt.Fprint(buf, "{")
+		for b := 0; b < 64; b++ {
+			fmt.Fprintf(buf, "%#016x, ", entry(a, b))
+		}
+
+		fmt.Fprintln(buf, "},")
+	}
+
+	fmt.Fprintln(buf, "}")
+}
+
+func main() {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_tables.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package engine")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// rayTable holds, for each square and direction, the squares that a sliding")
+	fmt.Fprintln(&buf, "// piece on that square attacks in that direction on an empty board.")
+	fmt.Fprintln(&buf, "var rayTable = [64][8]Bitboard{")
+	for square := 0; square < 64; square++ {
+		fmt.Fprint(&buf, "{")
+		for direction := range directions {
+			fmt.Fprintf(&buf, "%#016x, ", ray(square, direction))
+		}
+
+		fmt.Fprintln(&buf, "},")
+	}
+
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// pawnTable holds, for each square and color, the squares that a pawn of that")
+	fmt.Fprintln(&buf, "// color on that square attacks.")
+	fmt.Fprintln(&buf, "var pawnTable = [64][2]Bitboard{")
+	for square := 0; square < 64; square++ {
+		fmt.Fprintf(&buf, "{%#016x, %#016x},\n", pawnAttacks(square, 0), pawnAttacks(square, 1))
+	}
+
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// knightTable holds the squares that a knight on each square attacks.")
+	fmt.Fprintln(&buf, "var knightTable = [64]Bitboard{")
+	for square := 0; square < 64; square++ {
+		fmt.Fprintf(&buf, "%#016x,\n", knightAttacks(square))
+	}
+
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// kingTable holds the squares that a king on each square attacks.")
+	fmt.Fprintln(&buf, "var kingTable = [64]Bitboard{")
+	for square := 0; square < 64; square++ {
+		fmt.Fprintf(&buf, "%#016x,\n", kingAttacks(square))
+	}
+
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// betweenTable holds, for each pair of squares on a common rank, file or")
+	fmt.Fprintln(&buf, "// diagonal, the squares strictly between them.")
+	writeSquarePairTable(&buf, "betweenTable", between)
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// lineTable holds, for each pair of squares on a common rank, file or")
+	fmt.Fprintln(&buf, "// diagonal, every square on that line.")
+	writeSquarePairTable(&buf, "lineTable", line)
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// passedPawnTable holds, for each color and square, the squares ahead of a pawn")
+	fmt.Fprintln(&buf, "// of that color on that square, on its own file and the adjacent ones.")
+	writeColorSquareTable(&buf, "passedPawnTable", passedPawnSpan)
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// kingZoneTable holds, for each color and square, the zone around a king of")
+	fmt.Fprintln(&buf, "// that color on that square.")
+	writeColorSquareTable(&buf, "kingZoneTable", kingZone)
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated source: %s", err)
+	}
+
+	if err := os.WriteFile(output, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,32 @@
+package engine
+
+// Horde is the variant in which White has no king, only a horde of 36 pawns,
+// some of which start on the first rank. Black wins by capturing every white
+// piece, and White wins by checkmating Black as usual. White's pawns on the
+// first rank can move two squares, like the pawns on the second rank, but
+// can't be captured en passant when they do.
+var Horde Variant = horde{}
+
+type horde struct {
+	standard
+}
+
+func (horde) Name() string {
+	return "horde"
+}
+
+// VariantOutcome returns a win for Black once White has no pieces left.
+func (horde) VariantOutcome(pos *Position) (Result, bool) {
+	if pos.White().Empty() {
+		return BlackWins, true
+	}
+
+	return Draw, false
+}
+
+// hasPawnsOnFirstRank returns whether or not the given color can have pawns
+// on its first rank in this position's variant, which can move two squares
+// from there.
+func (p *Position) hasPawnsOnFirstRank(color Color) bool {
+	return p.variant == Horde && color == White
+}
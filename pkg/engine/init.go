@@ -1,13 +1,8 @@
 package engine
 
-import "sync"
-
-var initializeGuard sync.Once
-
-// Initialize performs all engine-wide initialization that needs
-// to occur before engine operations can begin.
-func Initialize() {
-	initializeGuard.Do(func() {
-		initializeAttackTables()
-	})
-}
+// Initialize used to build the attack tables that the engine depends on,
+// which had to happen before any other engine operation.
+//
+// Deprecated: the attack tables are now generated ahead of time, so there is
+// nothing to initialize and this does nothing.
+func Initialize() {}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+)
+
+// This file checks the internal consistency of positions. Unlike Validate,
+// which asks whether a position could arise in a game, CheckInvariants asks
+// whether the redundant parts of a position's representation agree with each
+// other, which they always should unless there is a bug in the engine. It is
+// run after every move when debug checks are enabled.
+
+var PositionColorBoardError = errors.New("color bitboard is not the union of its piece bitboards")
+var PositionOverlapError = errors.New("more than one piece on a square")
+var PositionMailboxError = errors.New("mailbox disagrees with the bitboards")
+
+// CheckInvariants checks that the bitboards, mailbox, castling rights and en
+// passant square of this position are consistent with each other. It returns
+// nil if they are, or a *ValidationError listing every inconsistency if they
+// are not.
+//
+// Positions don't carry a Zobrist hash yet, so there is no hash to check
+// against the board. That check belongs here once they do.
+func (p *Position) CheckInvariants() error {
+	var violations []error
+	violate := func(sentinel error, format string, args ...interface{}) {
+		violations = append(violations, fmt.Errorf("%w: %s", sentinel, fmt.Sprintf(format, args...)))
+	}
+
+	var seen Bitboard
+	for _, color := range [...]Color{White, Black} {
+		var union Bitboard
+		for kind := Pawn; kind <= King; kind++ {
+			piece := MakePiece(kind, color)
+			board := p.boardsByPiece[color][kind]
+			overlap := (seen & board).Iter()
+			for square, next := overlap.Next(); next; square, next = overlap.Next() {
+				violate(PositionOverlapError, "%s on %s", piece, square)
+			}
+
+			squares := board.Iter()
+			for square, next := squares.Next(); next; square, next = squares.Next() {
+				if p.mailbox[square] != piece {
+					violate(PositionMailboxError, "mailbox has %s on %s but the bitboards have %s",
+						p.mailbox[square], square, piece)
+				}
+			}
+
+			seen |= board
+			union |= board
+		}
+
+		if union != p.boardsByColor[color] {
+			violate(PositionColorBoardError, "%s pieces are on %#x but %s's bitboard is %#x",
+				color, uint64(union), color, uint64(p.boardsByColor[color]))
+		}
+	}
+
+	// every square that the color boards leave empty must be empty in the
+	// mailbox too, or PieceAt would see a piece that isn't there.
+	occupied := p.boardsByColor[White] | p.boardsByColor[Black]
+	for square := A1; square <= H8; square++ {
+		if !occupied.Test(square) && p.mailbox[square] != MakeNullPiece() {
+			violate(PositionMailboxError, "mailbox has %s on %s but the bitboards have it empty",
+				p.mailbox[square], square)
+		}
+	}
+
+	// the engine assumes that a side has at most one royal king. A side with
+	// none is a position that Validate rejects, not an inconsistent one, and
+	// the engine copes with it, so it isn't checked here.
+	for _, color := range [...]Color{White, Black} {
+		if kings := p.Kings(color).Count(); p.hasRoyalKing(color) && kings > 1 {
+			violate(PositionKingCountError, "%s has %d kings", color, kings)
+		}
+	}
+
+	for _, color := range [...]Color{White, Black} {
+		for _, kingside := range [...]bool{true, false} {
+			if err := p.castleRightsError(color, kingside); err != nil {
+				violations = append(violations, err)
+			}
+		}
+	}
+
+	if p.HasEnPassantSquare() && !p.enPassantSquareReachable() {
+		violate(PositionEnPassantError, "no %s pawn could have just double-pushed past %s",
+			p.sideToMove.Toggle(), p.enPassantSquare)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{violations}
+}
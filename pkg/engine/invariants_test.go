@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	t.Parallel()
+	tests := [...]struct {
+		name      string
+		corrupt   func(pos *Position)
+		violation error
+	}{
+		{"consistent", func(pos *Position) {}, nil},
+		{"color-board", func(pos *Position) { pos.boardsByColor[White].Unset(E1) }, PositionColorBoardError},
+		{"overlap", func(pos *Position) {
+			pos.boardsByPiece[Black][Knight].Set(E2)
+			pos.boardsByColor[Black].Set(E2)
+		}, PositionOverlapError},
+		{"mailbox", func(pos *Position) { pos.mailbox[D1] = MakePiece(King, White) }, PositionMailboxError},
+		{"stale-mailbox", func(pos *Position) { pos.mailbox[E4] = MakePiece(Queen, Black) }, PositionMailboxError},
+		{"two-kings", func(pos *Position) {
+			pos.removePieceOrPanic(D1)
+			pos.addPieceOrPanic(D1, MakePiece(King, White))
+		}, PositionKingCountError},
+		{"castle-rights", func(pos *Position) { pos.removePieceOrPanic(H1) }, PositionCastleRightsError},
+		{"en-passant", func(pos *Position) { pos.enPassantSquare = E6 }, PositionEnPassantError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos := MakeDefaultPosition()
+			test.corrupt(pos)
+			err := pos.CheckInvariants()
+			if test.violation == nil {
+				assert.NoError(tt, err)
+				return
+			}
+
+			assert.True(tt, errors.Is(err, test.violation), "%v", err)
+		})
+	}
+
+	t.Run("exploded-king", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("8/8/8/8/8/8/8/4K3 w - - 0 1", FenVariant(Atomic))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.NoError(tt, pos.CheckInvariants())
+	})
+}
+
+// This test isn't parallel, since it turns on the global debug checks.
+func TestApplyMoveChecksInvariants(t *testing.T) {
+	SetDebugChecks(true)
+	defer SetDebugChecks(false)
+
+	pos := MakeDefaultPosition()
+	assert.NotPanics(t, func() { pos.ApplyMove(MakeDoublePawnPushMove(E2, E4)) })
+
+	// a kingless position is invalid, but not inconsistent.
+	kingless, err := MakePositionFromFen("8/8/8/8/8/8/8/R7 w - - 0 1", FenLenient())
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.NotPanics(t, func() { kingless.ApplyMove(MakeQuietMove(A1, A2)) })
+	assert.NoError(t, kingless.CheckInvariants())
+
+	// a stray bit in a color board is only noticed by the invariant check.
+	pos.boardsByColor[White].Set(A5)
+	assert.Panics(t, func() { pos.ApplyMove(MakeDoublePawnPushMove(E7, E5)) })
+}
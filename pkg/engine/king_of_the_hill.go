@@ -0,0 +1,26 @@
+package engine
+
+// KingOfTheHill is the variant in which, in addition to the usual ways of
+// winning, a side wins by moving its king to one of the four center squares.
+var KingOfTheHill Variant = kingOfTheHill{}
+
+type kingOfTheHill struct {
+	standard
+}
+
+// the hill: d4, e4, d5 and e5.
+var hillSquares = Bitboard(1<<D4 | 1<<E4 | 1<<D5 | 1<<E5)
+
+func (kingOfTheHill) Name() string {
+	return "kingofthehill"
+}
+
+func (kingOfTheHill) VariantOutcome(pos *Position) (Result, bool) {
+	for _, color := range [...]Color{White, Black} {
+		if pos.Kings(color)&hillSquares != 0 {
+			return WinFor(color), true
+		}
+	}
+
+	return Draw, false
+}
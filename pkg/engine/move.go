@@ -2,6 +2,7 @@ package engine
 
 import (
 	"fmt"
+	"strings"
 )
 
 // A Move is a transformation on the game board. Each player can make moves
@@ -29,6 +30,7 @@ import (
 // | 0     | 0     | 1     | 1     | Queen Castle           |
 // | 0     | 1     | 0     | 0     | Capture                |
 // | 0     | 1     | 0     | 1     | En Passant Capture     |
+// | 0     | 1     | 1     | 0     | Drop                   |
 // | 1     | 0     | 0     | 0     | Knight Promote         |
 // | 1     | 0     | 0     | 1     | Bishop Promote         |
 // | 1     | 0     | 1     | 0     | Rook Promote           |
@@ -38,6 +40,15 @@ import (
 // | 1     | 1     | 1     | 0     | Rook Promote Capture   |
 // | 1     | 1     | 1     | 1     | Queen Promote Capture  |
 //
+// Castles are encoded as the king "capturing" the rook that it castles with,
+// rather than as the king moving to its destination square. In Chess960 the
+// king's destination alone isn't enough to identify which rook is castling,
+// and the king may not move at all.
+//
+// Drops, which put a piece from a Crazyhouse pocket onto the board, have no
+// source square. Their source bits hold the kind of the dropped piece
+// instead. Drops never capture, despite the capture bit in their encoding.
+//
 // Thanks to https://chessprogramming.wikispaces.com/Encoding+Moves
 // for the details.
 type Move uint16
@@ -50,6 +61,7 @@ const (
 	special0Bit     = 0x0002
 	special1Bit     = 0x0001
 	attrMask        = 0x000F
+	dropAttr        = captureBit | special0Bit
 )
 
 // MakeQuietMove constructs a new quiet move from the source
@@ -103,18 +115,30 @@ func MakePromotionCaptureMove(source, dest Square, piece PieceKind) Move {
 	return mov
 }
 
+// MakeKingsideCastleMove constructs a kingside castle by the king on the
+// source square with the rook on the destination square.
 func MakeKingsideCastleMove(source, dest Square) Move {
 	mov := MakeQuietMove(source, dest)
 	mov |= special0Bit
 	return mov
 }
 
+// MakeQueensideCastleMove constructs a queenside castle by the king on the
+// source square with the rook on the destination square.
 func MakeQueensideCastleMove(source, dest Square) Move {
 	mov := MakeQuietMove(source, dest)
 	mov |= special0Bit | special1Bit
 	return mov
 }
 
+// MakeDropMove constructs a drop of a piece of the given kind onto the
+// destination square.
+func MakeDropMove(piece PieceKind, dest Square) Move {
+	mov := MakeQuietMove(Square(piece), dest)
+	mov |= dropAttr
+	return mov
+}
+
 func MakeNullMove(source, dest Square) Move {
 	return Move(0)
 }
@@ -132,7 +156,7 @@ func (m Move) IsQuiet() bool {
 }
 
 func (m Move) IsCapture() bool {
-	return (m & captureBit) != 0
+	return (m&captureBit) != 0 && (m&attrMask) != dropAttr
 }
 
 func (m Move) IsEnPassant() bool {
@@ -159,6 +183,10 @@ func (m Move) IsCastle() bool {
 	return m.IsKingsideCastle() || m.IsQueensideCastle()
 }
 
+func (m Move) IsDrop() bool {
+	return (m & attrMask) == dropAttr
+}
+
 func (m Move) IsNull() bool {
 	return m == 0
 }
@@ -185,8 +213,37 @@ func (m Move) PromotionPiece() PieceKind {
 	panic("unreachable code in PromotionPiece")
 }
 
-// UciString returns a UCI-encoded representation of this move.
+// DropPiece returns the kind of the piece dropped by a drop move.
+func (m Move) DropPiece() PieceKind {
+	if !m.IsDrop() {
+		panic("DropPiece called on non-drop move")
+	}
+
+	return PieceKind((m & sourceMask) >> 10)
+}
+
+// UciString returns a UCI-encoded representation of this move, as written
+// with the UCI_Chess960 option unset. Use UciStringWithOptions to write a
+// move the way a position's options ask for.
 func (m Move) UciString() string {
+	return m.UciStringWithOptions(Options{})
+}
+
+// UciStringWithOptions returns a UCI-encoded representation of this move, as
+// written with the given options. Castles are written as the king moving to
+// its destination square, unless the UCI_Chess960 option is set, in which
+// case they are written as the king capturing its own rook. Drops are written
+// as the piece letter, an "@" and the destination square, e.g. "N@f3".
+func (m Move) UciStringWithOptions(opts Options) string {
+	if m.IsDrop() {
+		return fmt.Sprintf("%s@%s", strings.ToUpper(m.DropPiece().String()), m.Destination())
+	}
+
+	if m.IsCastle() && !opts.UciChess960 {
+		kingTarget, _ := castleTargets(m.Source().Rank(), m.IsKingsideCastle())
+		return fmt.Sprintf("%s%s", m.Source(), kingTarget)
+	}
+
 	if !m.IsPromotion() {
 		return fmt.Sprintf("%s%s", m.Source(), m.Destination())
 	}
@@ -1,10 +1,6 @@
 package engine
 
-func generatePawnMoves(pos *Position, moves []Move) []Move {
-	addMove := func(m Move) {
-		moves = append(moves, m)
-	}
-
+func generatePawnMoves(pos *Position, list *MoveList) {
 	color := pos.SideToMove()
 	enemyPieceMap := pos.Color(color.Toggle())
 	alliedPieceMap := pos.Color(color)
@@ -26,6 +22,8 @@ func generatePawnMoves(pos *Position, moves []Move) []Move {
 		enPassantDirection = North
 	}
 
+	firstRankPushes := pos.hasPawnsOnFirstRank(color)
+
 	pawns := pos.Pawns(color).Iter()
 	for pawn, hasNext := pawns.Next(); hasNext; pawn, hasNext = pawns.Next() {
 		// the general pawn move is that it moves one square in the pawn
@@ -34,19 +32,29 @@ func generatePawnMoves(pos *Position, moves []Move) []Move {
 
 		// non-capturing moves
 		if target.Rank() == promoRank && !allPieces.Test(target) {
-			addMove(MakePromotionMove(pawn, target, Bishop))
-			addMove(MakePromotionMove(pawn, target, Knight))
-			addMove(MakePromotionMove(pawn, target, Rook))
-			addMove(MakePromotionMove(pawn, target, Queen))
+			list.Add(MakePromotionMove(pawn, target, Bishop))
+			list.Add(MakePromotionMove(pawn, target, Knight))
+			list.Add(MakePromotionMove(pawn, target, Rook))
+			list.Add(MakePromotionMove(pawn, target, Queen))
 		} else if !allPieces.Test(target) {
-			addMove(MakeQuietMove(pawn, target))
+			list.Add(MakeQuietMove(pawn, target))
 		}
 
 		// double-pawn pushes, for pawns still on their starting square
 		if pawn.Rank() == startingRank {
 			twoPushTarget := target.Towards(pawnDirection)
 			if !allPieces.Test(target) && !allPieces.Test(twoPushTarget) {
-				addMove(MakeDoublePawnPushMove(pawn, twoPushTarget))
+				list.Add(MakeDoublePawnPushMove(pawn, twoPushTarget))
+			}
+		}
+
+		// in Horde, pawns on the first rank can move two squares too, but
+		// they can't be captured en passant, so these are quiet moves that
+		// don't set the en passant square.
+		if firstRankPushes && pawn.Rank() == backRank(color) {
+			twoPushTarget := target.Towards(pawnDirection)
+			if !allPieces.Test(target) && !allPieces.Test(twoPushTarget) {
+				list.Add(MakeQuietMove(pawn, twoPushTarget))
 			}
 		}
 
@@ -55,12 +63,12 @@ func generatePawnMoves(pos *Position, moves []Move) []Move {
 		for pawnAttack, hasNextAttack := pawnAttacks.Next(); hasNextAttack; pawnAttack, hasNextAttack = pawnAttacks.Next() {
 			if enemyPieceMap.Test(pawnAttack) {
 				if pawnAttack.Rank() == promoRank {
-					addMove(MakePromotionCaptureMove(pawn, pawnAttack, Bishop))
-					addMove(MakePromotionCaptureMove(pawn, pawnAttack, Knight))
-					addMove(MakePromotionCaptureMove(pawn, pawnAttack, Rook))
-					addMove(MakePromotionCaptureMove(pawn, pawnAttack, Queen))
+					list.Add(MakePromotionCaptureMove(pawn, pawnAttack, Bishop))
+					list.Add(MakePromotionCaptureMove(pawn, pawnAttack, Knight))
+					list.Add(MakePromotionCaptureMove(pawn, pawnAttack, Rook))
+					list.Add(MakePromotionCaptureMove(pawn, pawnAttack, Queen))
 				} else {
-					addMove(MakeCaptureMove(pawn, pawnAttack))
+					list.Add(MakeCaptureMove(pawn, pawnAttack))
 				}
 			}
 		}
@@ -70,21 +78,19 @@ func generatePawnMoves(pos *Position, moves []Move) []Move {
 			epSquare := pos.EnPassantSquare()
 			// would this be a normal legal attack for this pawn?
 			if PawnAttacks(pawn, color).Test(epSquare) {
-				// the attack square is directly behind the pawn that was pushed
-				attackSquare := epSquare.Towards(enPassantDirection)
-				addMove(MakeEnPassantMove(pawn, attackSquare))
+				// the pawn being captured is directly in front of the EP
+				// square, but the capturing pawn moves onto the EP square
+				// itself.
+				victimSquare := epSquare.Towards(enPassantDirection)
+				if pos.Pawns(color.Toggle()).Test(victimSquare) {
+					list.Add(MakeEnPassantMove(pawn, epSquare))
+				}
 			}
 		}
 	}
-
-	return moves
 }
 
-func generateKnightMoves(pos *Position, moves []Move) []Move {
-	addMove := func(m Move) {
-		moves = append(moves, m)
-	}
-
+func generateKnightMoves(pos *Position, list *MoveList) {
 	color := pos.SideToMove()
 	enemyPieceMap := pos.Color(color.Toggle())
 	alliedPieceMap := pos.Color(color)
@@ -93,24 +99,18 @@ func generateKnightMoves(pos *Position, moves []Move) []Move {
 		attacks := KnightAttacks(knight).Iter()
 		for knightAttack, next := attacks.Next(); next; knightAttack, next = attacks.Next() {
 			if enemyPieceMap.Test(knightAttack) {
-				addMove(MakeCaptureMove(knight, knightAttack))
+				list.Add(MakeCaptureMove(knight, knightAttack))
 			} else if !alliedPieceMap.Test(knightAttack) {
-				addMove(MakeQuietMove(knight, knightAttack))
+				list.Add(MakeQuietMove(knight, knightAttack))
 			}
 		}
 	}
-
-	return moves
 }
 
 func generateSlidingMoves(pos *Position,
-	moves []Move,
+	list *MoveList,
 	attackFunc func(Square, Bitboard) Bitboard,
-	boardFunc func(Color) Bitboard) []Move {
-	addMove := func(m Move) {
-		moves = append(moves, m)
-	}
-
+	boardFunc func(Color) Bitboard) {
 	color := pos.SideToMove()
 	enemyPieceMap := pos.Color(color.Toggle())
 	alliedPieceMap := pos.Color(color)
@@ -121,25 +121,18 @@ func generateSlidingMoves(pos *Position,
 			// in theory we only need to test the end of rays
 			// for occupancy...
 			if enemyPieceMap.Test(attack) {
-				addMove(MakeCaptureMove(piece, attack))
+				list.Add(MakeCaptureMove(piece, attack))
 			} else if !alliedPieceMap.Test(attack) {
-				addMove(MakeQuietMove(piece, attack))
+				list.Add(MakeQuietMove(piece, attack))
 			}
 		}
 	}
-
-	return moves
 }
 
-func generateKingMoves(pos *Position, moves []Move) []Move {
-	addMove := func(m Move) {
-		moves = append(moves, m)
-	}
-
+func generateKingMoves(pos *Position, list *MoveList) {
 	color := pos.SideToMove()
 	enemyPieceMap := pos.Color(color.Toggle())
 	alliedPieceMap := pos.Color(color)
-	allPieces := enemyPieceMap | alliedPieceMap
 
 	// there should only be one king but i guess it's cool to have an engine
 	// that can play chess variants with multiple kings
@@ -148,68 +141,79 @@ func generateKingMoves(pos *Position, moves []Move) []Move {
 		attacks := KingAttacks(king).Iter()
 		for attack, next := attacks.Next(); next; attack, next = attacks.Next() {
 			if enemyPieceMap.Test(attack) {
-				addMove(MakeCaptureMove(king, attack))
+				list.Add(MakeCaptureMove(king, attack))
 			} else if !alliedPieceMap.Test(attack) {
-				addMove(MakeQuietMove(king, attack))
+				list.Add(MakeQuietMove(king, attack))
 			}
+		}
 
-			// pseudo-legality as as a concept breaks down a little in the
-			// presence of castling.
-			//
-			// this move generator attempts to delegate the harder aspects
-			// of move generation (e.g. detection of absolute pins) to board
-			// evaluation, where we can clearly observe that any move that leads
-			// directly to the capture of a king is illegal. However, we /do/
-			// need to enforce castling rules here, because it is not immediately
-			// obvious during board evaluation that castling rules were broken
-			// in a previous move.
-			//
-			// therefore, there are two rules that are enforced here:
-			//  1. the king can't castle out of check
-			//  2. the king can't castle through check (no square that the king
-			//     "slides" over can be checked)
-			//
-			// we can do this check efficiently using our attack bitboards.
-			if !pos.IsCheck(color) {
-				if pos.CanCastleKingside(color) {
-					one := king.Towards(East)
-					two := one.Towards(East)
-					if !allPieces.Test(one) && !allPieces.Test(two) {
-						if pos.SquaresAttacking(color.Toggle(), one).Empty() &&
-							pos.SquaresAttacking(color.Toggle(), two).Empty() {
-							addMove(MakeKingsideCastleMove(king, two))
-						}
-					}
+		// pseudo-legality as as a concept breaks down a little in the
+		// presence of castling.
+		//
+		// this move generator attempts to delegate the harder aspects
+		// of move generation (e.g. detection of absolute pins) to board
+		// evaluation, where we can clearly observe that any move that leads
+		// directly to the capture of a king is illegal. However, we /do/
+		// need to enforce castling rules here, because it is not immediately
+		// obvious during board evaluation that castling rules were broken
+		// in a previous move.
+		//
+		// therefore, there are two rules that are enforced here:
+		//  1. the king can't castle out of check
+		//  2. the king can't castle through check (no square that the king
+		//     "slides" over can be checked)
+		//
+		// in Chess960, the king and rook can start on any file, so which
+		// squares need to be empty and unattacked depends on where they
+		// start and end; canCastleWith takes care of that.
+		if !pos.IsCheck(color) {
+			if pos.CanCastleKingside(color) {
+				rook := pos.KingsideCastleRook(color)
+				if pos.canCastleWith(king, rook, true) {
+					list.Add(MakeKingsideCastleMove(king, rook))
 				}
+			}
 
-				if pos.CanCastleQueenside(color) {
-					one := king.Towards(West)
-					two := one.Towards(West)
-					three := two.Towards(West)
-
-					// three can be checked, but it can't be occupied. this is because
-					// the rook needs to move "across" three, but the king does not.
-					if !allPieces.Test(one) && !allPieces.Test(two) && !allPieces.Test(three) {
-						if pos.SquaresAttacking(color.Toggle(), one).Empty() &&
-							pos.SquaresAttacking(color.Toggle(), two).Empty() {
-							addMove(MakeQueensideCastleMove(king, two))
-						}
-					}
+			if pos.CanCastleQueenside(color) {
+				rook := pos.QueensideCastleRook(color)
+				if pos.canCastleWith(king, rook, false) {
+					list.Add(MakeQueensideCastleMove(king, rook))
 				}
 			}
 		}
 	}
+}
 
-	return moves
+// generatePseudolegalMoves adds the pseudo-legal moves of standard chess to
+// the given list.
+func generatePseudolegalMoves(pos *Position, list *MoveList) {
+	generatePawnMoves(pos, list)
+	generateKnightMoves(pos, list)
+	generateSlidingMoves(pos, list, BishopAttacks, pos.Bishops)
+	generateSlidingMoves(pos, list, RookAttacks, pos.Rooks)
+	generateSlidingMoves(pos, list, QueenAttacks, pos.Queens)
+	generateKingMoves(pos, list)
 }
 
-func generatePseudolegalMoves(pos *Position) []Move {
-	moves := make([]Move, 0, options.moveGenerationBufferSize)
-	moves = generatePawnMoves(pos, moves)
-	moves = generateKnightMoves(pos, moves)
-	moves = generateSlidingMoves(pos, moves, BishopAttacks, pos.Bishops)
-	moves = generateSlidingMoves(pos, moves, RookAttacks, pos.Rooks)
-	moves = generateSlidingMoves(pos, moves, QueenAttacks, pos.Queens)
-	moves = generateKingMoves(pos, moves)
-	return moves
+// generateDropMoves generates a drop of every kind of piece in the side to
+// move's pocket onto every empty square, besides pawns onto the first and
+// eighth ranks.
+func generateDropMoves(pos *Position, list *MoveList) {
+	color := pos.SideToMove()
+	empty := ^(pos.White() | pos.Black())
+	for kind := Pawn; kind < King; kind++ {
+		if pos.Pocket(color, kind) == 0 {
+			continue
+		}
+
+		targets := empty
+		if kind == Pawn {
+			targets &= ^(FullBitboard.Rank(Rank1) | FullBitboard.Rank(Rank8))
+		}
+
+		squares := targets.Iter()
+		for square, next := squares.Next(); next; square, next = squares.Next() {
+			list.Add(MakeDropMove(kind, square))
+		}
+	}
 }
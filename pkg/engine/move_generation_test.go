@@ -29,7 +29,6 @@ func AssertHasMove(t *testing.T, fen string, mov Move) {
 }
 
 func TestMoveGeneration(t *testing.T) {
-	Initialize()
 	t.Parallel()
 	t.Run("early-game-rook", func(tt *testing.T) {
 		// both black and white have bumped their a-rank pawns. Now white
@@ -41,3 +40,83 @@ func TestMoveGeneration(t *testing.T) {
 		AssertHasMove(tt, "rnbqkbnr/1ppppppp/p7/8/8/3P4/PPP1PPPP/RNBQKBNR w KQkq - 0 2", MakeQuietMove(E1, D2))
 	})
 }
+
+func TestMoveGenerationRegressions(t *testing.T) {
+	t.Parallel()
+	t.Run("en-passant-destination", func(tt *testing.T) {
+		// the capturing pawn lands on the EP square, not on the square of
+		// the pawn it captured.
+		AssertHasMove(tt, "8/8/8/2k5/3Pp3/8/8/4K3 b - d3 0 1", MakeEnPassantMove(E4, D3))
+	})
+
+	t.Run("castles-generated-once", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		castles := 0
+		for _, mov := range pos.PseudolegalMoves() {
+			if mov.IsCastle() {
+				castles++
+			}
+		}
+
+		assert.Equal(tt, 2, castles)
+	})
+}
+
+func TestChess960Castling(t *testing.T) {
+	t.Parallel()
+	t.Run("king-does-not-move", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("6kr/8/8/8/8/8/8/6KR w Hh - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		mov := MakeKingsideCastleMove(G1, H1)
+		AssertHasMove(tt, pos.AsFen(), mov)
+		pos.ApplyMove(mov)
+		assert.Equal(tt, "6kr/8/8/8/8/8/8/5RK1 b k - 1 1", pos.AsFen())
+	})
+
+	t.Run("castle-and-king-move-to-same-square", func(tt *testing.T) {
+		// castling queenside puts the king on c1, which it can also
+		// reach with a normal king move.
+		pos, err := MakePositionFromFen("rk6/8/8/8/8/8/8/RK6 w Aa - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		legal := pos.LegalMoves()
+		assert.Contains(tt, legal, MakeQueensideCastleMove(B1, A1))
+		assert.Contains(tt, legal, MakeQuietMove(B1, C1))
+
+		applyUciMoves(tt, pos, "b1a1")
+		assert.Equal(tt, "rk6/8/8/8/8/8/8/2KR4 b a - 1 1", pos.AsShredderFen())
+	})
+
+	t.Run("rook-path-must-be-empty", func(tt *testing.T) {
+		// the king on b1 can reach c1, but the rook on a1 can't reach d1
+		// because the knight is in the way.
+		pos, err := MakePositionFromFen("rk6/8/8/8/8/8/8/RK1N4 w A - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		legal := pos.LegalMoves()
+		assert.Contains(tt, legal, MakeQuietMove(B1, C1))
+		assert.NotContains(tt, legal, MakeQueensideCastleMove(B1, A1))
+	})
+
+	t.Run("rook-shields-king-target", func(tt *testing.T) {
+		// the castling rook blocks the attack on c1 from the queen on a1,
+		// but it won't once it has moved to d1.
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/qR2K3 w B - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.NotContains(tt, pos.LegalMoves(), MakeQueensideCastleMove(E1, B1))
+	})
+}
@@ -0,0 +1,134 @@
+package engine
+
+// moveListCapacity is the number of moves that a MoveList can hold without
+// allocating. No legal chess position has more than 218 legal moves, so this
+// is enough for the pseudo-legal moves of any position outside of Crazyhouse,
+// where a full pocket can be dropped onto every empty square.
+const moveListCapacity = 256
+
+// A ScoredMove is a move and a score that is used to order it, where moves
+// with higher scores come first.
+type ScoredMove struct {
+	Move  Move
+	Score int32
+}
+
+// A MoveList is a list of moves, and their scores, that is stored in a fixed
+// size array so that it can be filled by the move generator without
+// allocating. The zero value is an empty list that is ready to use.
+//
+// A list that outgrows its array moves its contents to the heap.
+type MoveList struct {
+	moves  [moveListCapacity]ScoredMove
+	length int
+
+	// the contents of the list, once it has outgrown moves.
+	spill []ScoredMove
+}
+
+// entries returns the contents of the list.
+func (l *MoveList) entries() []ScoredMove {
+	if l.spill != nil {
+		return l.spill
+	}
+
+	return l.moves[:l.length]
+}
+
+// Add adds a move to the end of the list, with a score of zero.
+func (l *MoveList) Add(mov Move) {
+	if l.spill == nil && l.length < len(l.moves) {
+		l.moves[l.length] = ScoredMove{Move: mov}
+		l.length++
+		return
+	}
+
+	if l.spill == nil {
+		l.spill = make([]ScoredMove, l.length, 2*len(l.moves))
+		copy(l.spill, l.moves[:l.length])
+	}
+
+	l.spill = append(l.spill, ScoredMove{Move: mov})
+}
+
+// Len returns the number of moves in the list.
+func (l *MoveList) Len() int {
+	return len(l.entries())
+}
+
+// At returns the move at the given index of the list.
+func (l *MoveList) At(i int) Move {
+	return l.entries()[i].Move
+}
+
+// Score returns the score of the move at the given index of the list.
+func (l *MoveList) Score(i int) int32 {
+	return l.entries()[i].Score
+}
+
+// SetScore sets the score of the move at the given index of the list.
+func (l *MoveList) SetScore(i int, score int32) {
+	l.entries()[i].Score = score
+}
+
+// Swap swaps the moves, and their scores, at the given indices of the list.
+func (l *MoveList) Swap(i, j int) {
+	entries := l.entries()
+	entries[i], entries[j] = entries[j], entries[i]
+}
+
+// Sort sorts the list by score, highest first. Moves with the same score keep
+// their order.
+func (l *MoveList) Sort() {
+	// the lists are short enough that insertion sort does well, and unlike
+	// package sort, it doesn't need the list to escape to the heap.
+	entries := l.entries()
+	for i := 1; i < len(entries); i++ {
+		entry := entries[i]
+		j := i
+		for ; j > 0 && entries[j-1].Score < entry.Score; j-- {
+			entries[j] = entries[j-1]
+		}
+
+		entries[j] = entry
+	}
+}
+
+// Filter removes every move from the list for which keep returns false,
+// keeping the rest in order.
+func (l *MoveList) Filter(keep func(Move) bool) {
+	entries := l.entries()
+	kept := entries[:0]
+	for _, entry := range entries {
+		if keep(entry.Move) {
+			kept = append(kept, entry)
+		}
+	}
+
+	if l.spill != nil {
+		l.spill = kept
+	} else {
+		l.length = len(kept)
+	}
+}
+
+// Clear removes every move from the list.
+func (l *MoveList) Clear() {
+	l.length = 0
+	l.spill = nil
+}
+
+// Moves returns a copy of the moves in the list, or nil if it is empty.
+func (l *MoveList) Moves() []Move {
+	entries := l.entries()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	moves := make([]Move, len(entries))
+	for i, entry := range entries {
+		moves[i] = entry.Move
+	}
+
+	return moves
+}
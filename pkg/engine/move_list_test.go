@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveList(t *testing.T) {
+	t.Parallel()
+	t.Run("empty", func(tt *testing.T) {
+		var list MoveList
+		assert.Equal(tt, 0, list.Len())
+		assert.Nil(tt, list.Moves())
+	})
+
+	t.Run("add", func(tt *testing.T) {
+		var list MoveList
+		list.Add(MakeQuietMove(E2, E3))
+		list.Add(MakeDoublePawnPushMove(E2, E4))
+		assert.Equal(tt, 2, list.Len())
+		assert.Equal(tt, MakeQuietMove(E2, E3), list.At(0))
+		assert.Equal(tt, MakeDoublePawnPushMove(E2, E4), list.At(1))
+		assert.Equal(tt, int32(0), list.Score(1))
+		assert.Equal(tt, []Move{MakeQuietMove(E2, E3), MakeDoublePawnPushMove(E2, E4)}, list.Moves())
+	})
+
+	t.Run("sort", func(tt *testing.T) {
+		var list MoveList
+		list.Add(MakeQuietMove(A2, A3))
+		list.Add(MakeQuietMove(B2, B3))
+		list.Add(MakeQuietMove(C2, C3))
+		list.Add(MakeQuietMove(D2, D3))
+		list.SetScore(1, 10)
+		list.SetScore(3, 10)
+		list.SetScore(2, -5)
+		list.Sort()
+		assert.Equal(tt, []Move{
+			MakeQuietMove(B2, B3),
+			MakeQuietMove(D2, D3),
+			MakeQuietMove(A2, A3),
+			MakeQuietMove(C2, C3),
+		}, list.Moves())
+		assert.Equal(tt, int32(10), list.Score(0))
+		assert.Equal(tt, int32(-5), list.Score(3))
+	})
+
+	t.Run("filter", func(tt *testing.T) {
+		var list MoveList
+		list.Add(MakeQuietMove(A2, A3))
+		list.Add(MakeCaptureMove(B2, C3))
+		list.Add(MakeQuietMove(D2, D3))
+		list.Filter(Move.IsQuiet)
+		assert.Equal(tt, []Move{MakeQuietMove(A2, A3), MakeQuietMove(D2, D3)}, list.Moves())
+	})
+
+	t.Run("spill", func(tt *testing.T) {
+		var list MoveList
+		for i := 0; i < moveListCapacity+10; i++ {
+			list.Add(MakeQuietMove(Square(i%64), Square((i+1)%64)))
+		}
+
+		assert.Equal(tt, moveListCapacity+10, list.Len())
+		assert.Equal(tt, MakeQuietMove(A1, B1), list.At(0))
+		assert.Equal(tt, MakeQuietMove(Square(moveListCapacity%64), Square((moveListCapacity+1)%64)), list.At(moveListCapacity))
+		list.Filter(func(mov Move) bool {
+			return mov.Source() == A1
+		})
+		assert.Equal(tt, (moveListCapacity+10+63)/64, list.Len())
+		list.Clear()
+		assert.Equal(tt, 0, list.Len())
+	})
+}
+
+func TestGenerateLegalMovesMatchesLegalMoves(t *testing.T) {
+	t.Parallel()
+	pos, err := MakePositionFromFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	var list MoveList
+	pos.GenerateLegalMoves(&list, new(Position))
+	assert.Equal(t, 48, list.Len())
+	assert.Equal(t, pos.LegalMoves(), list.Moves())
+}
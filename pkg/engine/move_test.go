@@ -45,6 +45,18 @@ func TestMoveEncodings(t *testing.T) {
 			assert.Equal(tt, kind, mov.PromotionPiece())
 		})
 	}
+
+	for kind := Pawn; kind != King; kind++ {
+		t.Run(fmt.Sprintf("drop-%s", kind), func(tt *testing.T) {
+			mov := MakeDropMove(kind, F3)
+			assert.True(tt, mov.IsDrop())
+			assert.False(tt, mov.IsCapture())
+			assert.False(tt, mov.IsQuiet())
+			assert.False(tt, mov.IsCastle())
+			assert.Equal(tt, kind, mov.DropPiece())
+			assert.Equal(tt, F3, mov.Destination())
+		})
+	}
 }
 
 func TestMoveUciStrings(t *testing.T) {
@@ -58,4 +70,29 @@ func TestMoveUciStrings(t *testing.T) {
 		mov := MakePromotionMove(H7, H8, Queen)
 		assert.Equal(tt, "h7h8q", mov.UciString())
 	})
+
+	t.Run("drop", func(tt *testing.T) {
+		assert.Equal(tt, "N@f3", MakeDropMove(Knight, F3).UciString())
+		assert.Equal(tt, "P@e4", MakeDropMove(Pawn, E4).UciString())
+	})
+}
+
+// This test toggles the global UCI_Chess960 option, so it can't run in
+// parallel with the other tests.
+func TestMoveUciStringsCastle(t *testing.T) {
+	defer SetUciChess960(false)
+	kingside := MakeKingsideCastleMove(E1, H1)
+	queenside := MakeQueensideCastleMove(E8, A8)
+	assert.Equal(t, "e1g1", kingside.UciStringWithOptions(DefaultOptions()))
+	assert.Equal(t, "e8c8", queenside.UciStringWithOptions(DefaultOptions()))
+
+	chess960 := DefaultOptions()
+	chess960.UciChess960 = true
+	assert.Equal(t, "e1h1", kingside.UciStringWithOptions(chess960))
+	assert.Equal(t, "e8a8", queenside.UciStringWithOptions(chess960))
+
+	// UciString doesn't look at the global options at all.
+	SetUciChess960(true)
+	assert.Equal(t, "e1g1", kingside.UciString())
+	assert.Equal(t, "e8c8", queenside.UciString())
 }
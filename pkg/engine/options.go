@@ -1,15 +1,195 @@
 package engine
 
-import "runtime"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
 
+var OptionsInvalidValueError = errors.New("invalid option value")
+var OptionsUnknownNameError = errors.New("unknown option")
+
+// the bounds of the numeric options.
+const (
+	maxThreads       = 512
+	maxWorkQueueSize = 1 << 20
+)
+
+// Options are the settings of the engine that can be changed by its users.
+// Each option has a UCI name, which is the name used by the UCI "setoption"
+// command and in configuration files.
+//
+// The engine has a global set of options, which is used by anything that
+// isn't given options of its own; see SetOptions. Each Position takes a copy
+// of the global options when it is made, which Position.SetOptions replaces,
+// so options that apply to a single perft can be passed to it directly.
 type Options struct {
-	debugChecks              bool
-	moveGenerationBufferSize int
-	workQueueBufferSize      int
-	workQueueNumGoroutines   int
+	// DebugChecks turns on expensive checks of the engine's own work, e.g.
+	// that every move that it applies is pseudo-legal and leaves the
+	// position consistent. UCI name: DebugChecks.
+	DebugChecks bool
+
+	// UciChess960 controls whether castles are written in UCI notation as
+	// the king moving to its destination square (when false) or as the king
+	// capturing its own rook (when true). UCI name: UCI_Chess960.
+	UciChess960 bool
+
+	// Threads is the number of goroutines that work is divided between.
+	// UCI name: Threads.
+	Threads int
+
+	// WorkQueueSize is the number of pieces of work that can be waiting for
+	// a free thread before more work can be queued. UCI name: WorkQueueSize.
+	WorkQueueSize int
+}
+
+// DefaultOptions returns the options that the engine starts with.
+func DefaultOptions() Options {
+	return Options{
+		DebugChecks:   false,
+		UciChess960:   false,
+		Threads:       1,
+		WorkQueueSize: 1024,
+	}
+}
+
+var options = DefaultOptions()
+
+// CurrentOptions returns the engine's global options.
+func CurrentOptions() Options {
+	return options
+}
+
+// SetOptions sets the engine's global options, if they are valid. It must not
+// be called while other goroutines are using the engine.
+func SetOptions(opts Options) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	options = opts
+	return nil
+}
+
+// SetDebugChecks sets the global DebugChecks option. The checks are
+// expensive, so they are off by default.
+func SetDebugChecks(enabled bool) {
+	options.DebugChecks = enabled
+}
+
+// SetUciChess960 sets the global UCI_Chess960 option.
+func SetUciChess960(enabled bool) {
+	options.UciChess960 = enabled
+}
+
+// Validate returns an error wrapping OptionsInvalidValueError if any option is
+// out of range, or nil otherwise.
+func (o *Options) Validate() error {
+	if o.Threads < 1 || o.Threads > maxThreads {
+		return fmt.Errorf("%w: Threads must be between 1 and %d, not %d",
+			OptionsInvalidValueError, maxThreads, o.Threads)
+	}
+
+	if o.WorkQueueSize < 0 || o.WorkQueueSize > maxWorkQueueSize {
+		return fmt.Errorf("%w: WorkQueueSize must be between 0 and %d, not %d",
+			OptionsInvalidValueError, maxWorkQueueSize, o.WorkQueueSize)
+	}
+
+	return nil
+}
+
+// SetUciOption sets the option with the given UCI name, which is not case
+// sensitive, from the value of a UCI "setoption" command. The options are left
+// unchanged if the value is invalid.
+func (o *Options) SetUciOption(name, value string) error {
+	updated := *o
+	var err error
+	switch strings.ToLower(name) {
+	case "debugchecks":
+		updated.DebugChecks, err = strconv.ParseBool(value)
+	case "uci_chess960":
+		updated.UciChess960, err = strconv.ParseBool(value)
+	case "threads":
+		updated.Threads, err = strconv.Atoi(value)
+	case "workqueuesize":
+		updated.WorkQueueSize, err = strconv.Atoi(value)
+	default:
+		return fmt.Errorf("%w: %s", OptionsUnknownNameError, name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %s can't be %q", OptionsInvalidValueError, name, value)
+	}
+
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	*o = updated
+	return nil
+}
+
+// UciOptions returns the "option" lines that a UCI engine sends in response to
+// the "uci" command, which declare each option along with its default.
+func UciOptions() []string {
+	defaults := DefaultOptions()
+	return []string{
+		fmt.Sprintf("option name DebugChecks type check default %t", defaults.DebugChecks),
+		fmt.Sprintf("option name UCI_Chess960 type check default %t", defaults.UciChess960),
+		fmt.Sprintf("option name Threads type spin default %d min 1 max %d", defaults.Threads, maxThreads),
+		fmt.Sprintf("option name WorkQueueSize type spin default %d min 0 max %d", defaults.WorkQueueSize, maxWorkQueueSize),
+	}
 }
 
-var options = Options{debugChecks: false,
-	moveGenerationBufferSize: 128,
-	workQueueBufferSize:      1024,
-	workQueueNumGoroutines:   runtime.NumCPU()}
+// ReadOptions reads options from a JSON object whose keys are UCI option
+// names and whose values are what "setoption" would set them to, e.g.
+// {"Threads": 4, "DebugChecks": true}. Options that aren't given keep their
+// defaults.
+func ReadOptions(reader io.Reader) (Options, error) {
+	var values map[string]json.RawMessage
+	if err := json.NewDecoder(reader).Decode(&values); err != nil {
+		return Options{}, err
+	}
+
+	// set the options in a fixed order, so that the same file always fails
+	// with the same error.
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	opts := DefaultOptions()
+	for _, name := range names {
+		// strings are unquoted, while booleans and numbers are already
+		// written the way that "setoption" expects.
+		value := string(values[name])
+		var str string
+		if json.Unmarshal(values[name], &str) == nil {
+			value = str
+		}
+
+		if err := opts.SetUciOption(name, value); err != nil {
+			return Options{}, err
+		}
+	}
+
+	return opts, nil
+}
+
+// LoadOptions reads options from the JSON configuration file at the given
+// path, as described by ReadOptions.
+func LoadOptions(path string) (Options, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Options{}, err
+	}
+
+	defer file.Close()
+	return ReadOptions(file)
+}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultOptionsAreValid(t *testing.T) {
+	t.Parallel()
+	opts := DefaultOptions()
+	assert.NoError(t, opts.Validate())
+}
+
+func TestSetUciOption(t *testing.T) {
+	t.Parallel()
+	t.Run("names", func(tt *testing.T) {
+		opts := DefaultOptions()
+		assert.NoError(tt, opts.SetUciOption("DebugChecks", "true"))
+		assert.NoError(tt, opts.SetUciOption("uci_chess960", "true"))
+		assert.NoError(tt, opts.SetUciOption("Threads", "8"))
+		assert.NoError(tt, opts.SetUciOption("WORKQUEUESIZE", "16"))
+		assert.Equal(tt, Options{DebugChecks: true, UciChess960: true, Threads: 8, WorkQueueSize: 16}, opts)
+	})
+
+	t.Run("unknown", func(tt *testing.T) {
+		opts := DefaultOptions()
+		err := opts.SetUciOption("Hash", "16")
+		assert.True(tt, errors.Is(err, OptionsUnknownNameError), "%v", err)
+	})
+
+	for _, test := range [...]struct{ name, value string }{
+		{"Threads", "0"},
+		{"Threads", "many"},
+		{"WorkQueueSize", "-1"},
+		{"DebugChecks", "sometimes"},
+	} {
+		test := test
+		t.Run("invalid-"+test.name+"-"+test.value, func(tt *testing.T) {
+			opts := DefaultOptions()
+			err := opts.SetUciOption(test.name, test.value)
+			assert.True(tt, errors.Is(err, OptionsInvalidValueError), "%v", err)
+			assert.Equal(tt, DefaultOptions(), opts)
+		})
+	}
+}
+
+func TestReadOptions(t *testing.T) {
+	t.Parallel()
+	t.Run("partial", func(tt *testing.T) {
+		opts, err := ReadOptions(strings.NewReader(`{"Threads": 4, "DebugChecks": "true"}`))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		expected := DefaultOptions()
+		expected.Threads = 4
+		expected.DebugChecks = true
+		assert.Equal(tt, expected, opts)
+	})
+
+	t.Run("unknown", func(tt *testing.T) {
+		_, err := ReadOptions(strings.NewReader(`{"Threads": 4, "Ponder": true}`))
+		assert.True(tt, errors.Is(err, OptionsUnknownNameError), "%v", err)
+	})
+
+	t.Run("out-of-range", func(tt *testing.T) {
+		_, err := ReadOptions(strings.NewReader(`{"Threads": 100000}`))
+		assert.True(tt, errors.Is(err, OptionsInvalidValueError), "%v", err)
+	})
+
+	t.Run("not-json", func(tt *testing.T) {
+		_, err := ReadOptions(strings.NewReader(`Threads = 4`))
+		assert.Error(tt, err)
+	})
+}
+
+func TestUciOptions(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{
+		"option name DebugChecks type check default false",
+		"option name UCI_Chess960 type check default false",
+		"option name Threads type spin default 1 min 1 max 512",
+		"option name WorkQueueSize type spin default 1024 min 0 max 1048576",
+	}, UciOptions())
+}
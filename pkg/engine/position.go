@@ -9,16 +9,25 @@ import (
 // A Position is a single game state at a point in time. It contains
 // all of the information necessary to completely describe a game of
 // Chess at a particular instant.
+//
+// A Position holds no references to memory outside of itself, so copying the
+// struct copies the position. Clone does exactly that.
 type Position struct {
 	// Bitboards for every piece kind on the board. The first dimension of
 	// this two-dimensional array is the color of the piece, while the second
 	// dimension is the kind of the piece.
-	boardsByPiece [][]Bitboard
+	boardsByPiece [2][6]Bitboard
 
 	// Bitboards for each color. This exists purely for efficiency reasons;
 	// the contents of this array can always be calculated by or'ing the
 	// contents of one dimension of boardsByPiece.
-	boardsByColor []Bitboard
+	boardsByColor [2]Bitboard
+
+	// The piece on each square, which is the null piece for empty squares.
+	// Like boardsByColor, this can always be calculated from boardsByPiece
+	// and exists so that PieceAt can find the piece on a square without
+	// searching every bitboard.
+	mailbox [64]Piece
 
 	// The current en passant square, if an en passant move is legal from
 	// this position, or InvalidSquare if no such move is legal.
@@ -32,40 +41,92 @@ type Position struct {
 
 	// The castling status of the game.
 	castleStatus uint8
+
+	// The squares of the rooks that each color may castle with, indexed
+	// first by color and then by castleKingside or castleQueenside. These
+	// are the corner squares in standard chess, but can be on any file in
+	// Chess960.
+	castleRooks [2][2]Square
+
+	// Whether or not this is a Chess960 position, in which case the king
+	// and rooks are not required to start on their standard squares.
+	chess960 bool
+
+	// The variant whose rules this position is played by.
+	variant Variant
+
+	// The number of checks that each color has given, for three-check.
+	checksGiven [2]uint8
+
+	// The number of pieces of each kind in each color's pocket, for
+	// Crazyhouse, indexed first by color and then by piece kind.
+	pockets [2][6]uint8
+
+	// The squares of the pieces that were promoted from pawns, which are
+	// only tracked in Crazyhouse, where a captured promoted piece goes back
+	// into its captor's pocket as a pawn.
+	promoted Bitboard
+
+	// The engine options that this position uses, which are copied from the
+	// global ones when it is made and passed on to every copy of it.
+	options Options
 }
 
 // MakeEmptyPosition creates a new position representing an empty board
 // with all state set to their defaults.
 func MakeEmptyPosition() *Position {
-	pos := &Position{
-		boardsByPiece:   make([][]Bitboard, 2),
-		boardsByColor:   make([]Bitboard, 2),
+	return &Position{
 		enPassantSquare: InvalidSquare,
 		halfmoveClock:   0,
 		fullmoveClock:   0,
 		sideToMove:      White,
-		castleStatus:    0}
+		castleStatus:    0,
+		castleRooks:     [2][2]Square{{H1, A1}, {H8, A8}},
+		variant:         Standard,
+		options:         options}
+}
 
-	pos.boardsByPiece[White] = make([]Bitboard, 6)
-	pos.boardsByPiece[Black] = make([]Bitboard, 6)
-	return pos
+// Options returns the engine options that this position uses.
+func (p *Position) Options() Options {
+	return p.options
+}
+
+// SetOptions sets the engine options that this position uses, and that the
+// positions copied from it inherit, in place of the global options that it
+// was made with.
+func (p *Position) SetOptions(opts Options) {
+	p.options = opts
 }
 
 // PieceAt returns the Piece that resides at the given square, if one exists.
 func (p *Position) PieceAt(square Square) (Piece, bool) {
-	var color Color
-	if p.boardsByColor[White].Test(square) {
-		color = White
-	} else if p.boardsByColor[Black].Test(square) {
-		color = Black
-	} else {
+	// the null piece in the mailbox looks just like a white pawn, so the
+	// bitboards decide whether or not the square is empty.
+	if !(p.boardsByColor[White] | p.boardsByColor[Black]).Test(square) {
 		return MakeNullPiece(), false
 	}
 
+	piece := p.mailbox[square]
+	if p.options.DebugChecks && piece != p.pieceAtFromBitboards(square) {
+		panic(fmt.Sprintf("mailbox has %s on %s, but the bitboards disagree", piece, square))
+	}
+
+	return piece, true
+}
+
+// pieceAtFromBitboards returns the piece on the given occupied square by
+// searching the bitboards, which is much slower than reading the mailbox but
+// can be used to check that the two agree.
+func (p *Position) pieceAtFromBitboards(square Square) Piece {
+	color := White
+	if p.boardsByColor[Black].Test(square) {
+		color = Black
+	}
+
 	piecesBoard := p.boardsByPiece[color]
 	for piece := Pawn; piece <= King; piece++ {
 		if piecesBoard[piece].Test(square) {
-			return MakePiece(piece, color), true
+			return MakePiece(piece, color)
 		}
 	}
 
@@ -82,6 +143,7 @@ func (p *Position) AddPiece(square Square, piece Piece) error {
 
 	p.boardsByColor[piece.color].Set(square)
 	p.boardsByPiece[piece.color][piece.kind].Set(square)
+	p.mailbox[square] = piece
 	return nil
 }
 
@@ -91,6 +153,7 @@ func (p *Position) RemovePiece(square Square) error {
 	if piece, hasPiece := p.PieceAt(square); hasPiece {
 		p.boardsByColor[piece.color].Unset(square)
 		p.boardsByPiece[piece.color][piece.kind].Unset(square)
+		p.mailbox[square] = MakeNullPiece()
 		return nil
 	}
 
@@ -157,6 +220,34 @@ func (p *Position) SideToMove() Color {
 	return p.sideToMove
 }
 
+// Variant returns the variant whose rules this position is played by.
+func (p *Position) Variant() Variant {
+	return p.variant
+}
+
+// ChecksGiven returns the number of checks that the given color has given,
+// which is only tracked in three-check.
+func (p *Position) ChecksGiven(color Color) int {
+	return int(p.checksGiven[color])
+}
+
+// Pocket returns the number of pieces of the given kind in the given color's
+// pocket, which is only tracked in Crazyhouse.
+func (p *Position) Pocket(color Color, kind PieceKind) int {
+	return int(p.pockets[color][kind])
+}
+
+// Promoted returns a bitboard of the pieces that were promoted from pawns,
+// which is only tracked in Crazyhouse.
+func (p *Position) Promoted() Bitboard {
+	return p.promoted
+}
+
+// IsChess960 returns whether or not this is a Chess960 position.
+func (p *Position) IsChess960() bool {
+	return p.chess960
+}
+
 func (p *Position) CanCastleKingside(color Color) bool {
 	if color == White {
 		return (p.castleStatus & whiteOO) == whiteOO
@@ -173,6 +264,66 @@ func (p *Position) CanCastleQueenside(color Color) bool {
 	}
 }
 
+// KingsideCastleRook returns the square of the rook that the given color
+// castles kingside with. It is only meaningful if that color can castle
+// kingside.
+func (p *Position) KingsideCastleRook(color Color) Square {
+	return p.castleRooks[color][castleKingside]
+}
+
+// QueensideCastleRook returns the square of the rook that the given color
+// castles queenside with. It is only meaningful if that color can castle
+// queenside.
+func (p *Position) QueensideCastleRook(color Color) Square {
+	return p.castleRooks[color][castleQueenside]
+}
+
+// castleKing returns the square of the given color's king, if it is on that
+// color's back rank.
+func (p *Position) castleKing(color Color) (Square, bool) {
+	kings := (p.Kings(color) & FullBitboard.Rank(backRank(color))).Iter()
+	return kings.Next()
+}
+
+// canCastleWith returns whether or not the king on the given square can
+// castle with the rook on the given square, other than the requirement that
+// the king isn't in check: every square that the king and the rook travel
+// over must be empty, besides the squares of the king and rook themselves,
+// and no square that the king travels over can be attacked.
+func (p *Position) canCastleWith(king, rook Square, kingside bool) bool {
+	color := p.sideToMove
+	if king.Rank() != rook.Rank() || !p.Rooks(color).Test(rook) {
+		return false
+	}
+
+	kingTarget, rookTarget := castleTargets(king.Rank(), kingside)
+	occupancy := p.White() | p.Black()
+	occupancy.Unset(king)
+	occupancy.Unset(rook)
+	kingPath := rankSpan(king, kingTarget)
+	if occupancy&(kingPath|rankSpan(rook, rookTarget)) != 0 {
+		return false
+	}
+
+	// in atomic, the king can travel over attacked squares that touch the
+	// opposing king, since it can't be captured there.
+	if p.explodesOnCapture() {
+		kings := p.Kings(color.Toggle()).Iter()
+		for king, next := kings.Next(); next; king, next = kings.Next() {
+			kingPath &^= KingAttacks(king)
+		}
+	}
+
+	squares := kingPath.Iter()
+	for square, next := squares.Next(); next; square, next = squares.Next() {
+		if !p.SquaresAttacking(color.Toggle(), square).Empty() {
+			return false
+		}
+	}
+
+	return true
+}
+
 // SquaresAttacking returns a bitboard of pieces of the given color
 // that are currently attacking the given square. This is useful for detecting
 // pins or checked squares.
@@ -240,6 +391,12 @@ func (p *Position) IsCheck(color Color) bool {
 		return false
 	}
 
+	if p.explodesOnCapture() && p.kingsTouching() {
+		// in atomic, capturing a king that's touching your own would
+		// explode both of them, so kings that touch can't be in check.
+		return false
+	}
+
 	kingIter := kings.Iter()
 	for king, next := kingIter.Next(); next; king, next = kingIter.Next() {
 		if p.SquaresAttacking(color.Toggle(), king) != 0 {
@@ -250,6 +407,80 @@ func (p *Position) IsCheck(color Color) bool {
 	return false
 }
 
+// Checkers returns a bitboard of the pieces that are giving check to the side
+// to move.
+func (p *Position) Checkers() Bitboard {
+	if p.explodesOnCapture() && p.kingsTouching() {
+		// see IsCheck.
+		return EmptyBitboard
+	}
+
+	checkers := EmptyBitboard
+	kings := p.Kings(p.sideToMove).Iter()
+	for king, next := kings.Next(); next; king, next = kings.Next() {
+		checkers |= p.SquaresAttacking(p.sideToMove.Toggle(), king)
+	}
+
+	return checkers
+}
+
+// Pinned returns a bitboard of the pieces of the given color that are
+// absolutely pinned: the only piece standing between their king and an enemy
+// slider that would otherwise attack it.
+func (p *Position) Pinned(color Color) Bitboard {
+	occupancy := p.White() | p.Black()
+	enemy := color.Toggle()
+	pinned := EmptyBitboard
+	kings := p.Kings(color).Iter()
+	for king, next := kings.Next(); next; king, next = kings.Next() {
+		// the sliders that would attack the king if nothing was in the way.
+		snipers := (RookAttacks(king, EmptyBitboard) & (p.Rooks(enemy) | p.Queens(enemy))) |
+			(BishopAttacks(king, EmptyBitboard) & (p.Bishops(enemy) | p.Queens(enemy)))
+		sniperIter := snipers.Iter()
+		for sniper, next := sniperIter.Next(); next; sniper, next = sniperIter.Next() {
+			blockers := Between(king, sniper) & occupancy
+			if blockers.Count() == 1 {
+				pinned |= blockers & p.Color(color)
+			}
+		}
+	}
+
+	return pinned
+}
+
+// AttackedSquares returns a bitboard of every square that a piece of the
+// given color attacks, whether or not it is occupied.
+func (p *Position) AttackedSquares(color Color) Bitboard {
+	occupancy := p.White() | p.Black()
+	attacked := EmptyBitboard
+	pawns := p.Pawns(color).Iter()
+	for pawn, next := pawns.Next(); next; pawn, next = pawns.Next() {
+		attacked |= PawnAttacks(pawn, color)
+	}
+
+	knights := p.Knights(color).Iter()
+	for knight, next := knights.Next(); next; knight, next = knights.Next() {
+		attacked |= KnightAttacks(knight)
+	}
+
+	bishops := (p.Bishops(color) | p.Queens(color)).Iter()
+	for bishop, next := bishops.Next(); next; bishop, next = bishops.Next() {
+		attacked |= BishopAttacks(bishop, occupancy)
+	}
+
+	rooks := (p.Rooks(color) | p.Queens(color)).Iter()
+	for rook, next := rooks.Next(); next; rook, next = rooks.Next() {
+		attacked |= RookAttacks(rook, occupancy)
+	}
+
+	kings := p.Kings(color).Iter()
+	for king, next := kings.Next(); next; king, next = kings.Next() {
+		attacked |= KingAttacks(king)
+	}
+
+	return attacked
+}
+
 // IsMovePseudoLegal performs a pseudo-legality test on the given move
 // and returns whether or not it can be pseudo-legally played in the current
 // game.
@@ -263,6 +494,10 @@ func (p *Position) IsMovePseudoLegal(mov Move) bool {
 		return true
 	}
 
+	if mov.IsDrop() {
+		return p.isDropPseudoLegal(mov)
+	}
+
 	// rule 1: there must be a piece on the source square
 	sourcePiece, ok := p.PieceAt(mov.Source())
 	if !ok {
@@ -278,8 +513,13 @@ func (p *Position) IsMovePseudoLegal(mov Move) bool {
 	// rule 3: if there is a piece on the target square...
 	//      3.1: the piece must be owned by the other player
 	//      3.2: the move must be a capture
+	// ...unless the move is a castle, which captures our own rook.
 	destinationPiece, hasPiece := p.PieceAt(mov.Destination())
-	if hasPiece {
+	if mov.IsCastle() {
+		if !hasPiece || destinationPiece.kind != Rook || destinationPiece.color != p.sideToMove {
+			return false
+		}
+	} else if hasPiece {
 		if !mov.IsCapture() {
 			return false
 		}
@@ -295,14 +535,61 @@ func (p *Position) IsMovePseudoLegal(mov Move) bool {
 	return true
 }
 
-// PseudolegalMoves generates all pseudo-legal moves available from the
-// given position.
+// GeneratePseudolegalMoves adds all pseudo-legal moves available from the
+// given position to the list.
+func (p *Position) GeneratePseudolegalMoves(list *MoveList) {
+	p.variant.PseudolegalMoves(p, list)
+}
+
+// GenerateLegalMoves adds all legal moves available from the given position
+// to the list, by filtering out the pseudo-legal moves that are illegal in
+// the position's variant (in standard chess, those that leave the mover in
+// check). There are no legal moves once the game has ended.
+//
+// The list should be empty, since moves already in it are filtered too.
+// Moves are tried out on scratch, which is overwritten, so that generating
+// them doesn't allocate.
+func (p *Position) GenerateLegalMoves(list *MoveList, scratch *Position) {
+	if _, over := p.variant.VariantOutcome(p); over {
+		return
+	}
+
+	p.GeneratePseudolegalMoves(list)
+	p.variant.LegalMoves(p, list, scratch)
+}
+
+// PseudolegalMoves returns all pseudo-legal moves available from the given
+// position. GeneratePseudolegalMoves avoids allocating the returned slice.
 func (p *Position) PseudolegalMoves() []Move {
-	return generatePseudolegalMoves(p)
+	var list MoveList
+	p.GeneratePseudolegalMoves(&list)
+	return list.Moves()
+}
+
+// LegalMoves returns all legal moves available from the given position.
+// GenerateLegalMoves avoids allocating the returned slice.
+func (p *Position) LegalMoves() []Move {
+	var list MoveList
+	p.GenerateLegalMoves(&list, new(Position))
+	return list.Moves()
+}
+
+// Outcome returns the result of the game if it has ended, either by a rule of
+// the position's variant or because the side to move has no legal moves.
+func (p *Position) Outcome() (Result, bool) {
+	if result, over := p.variant.VariantOutcome(p); over {
+		return result, true
+	}
+
+	if len(p.LegalMoves()) == 0 {
+		return p.variant.NoMovesOutcome(p), true
+	}
+
+	return Draw, false
 }
 
 func (p *Position) ApplyMove(mov Move) {
-	if options.debugChecks && !p.IsMovePseudoLegal(mov) {
+	if p.options.DebugChecks && !p.IsMovePseudoLegal(mov) {
 		panic("ApplyMove called on a move that is not pseudo-legal")
 	}
 
@@ -313,21 +600,35 @@ func (p *Position) ApplyMove(mov Move) {
 		return
 	}
 
-	movingPiece := p.pieceAtOrPanic(mov.Source())
-	if options.debugChecks && movingPiece.color != p.sideToMove {
-		panic("moving a piece that does not belong to the moving player")
-	}
-
 	// the basic strategy here is to remove the piece from the start square
 	// and add it to the target square, removing the piece at the target
-	// square if this is a capture.
-	p.removePieceOrPanic(mov.Source())
+	// square if this is a capture. dropped pieces come from the pocket
+	// instead of the start square.
+	var movingPiece Piece
+	wasPromoted := false
+	if mov.IsDrop() {
+		movingPiece = MakePiece(mov.DropPiece(), p.sideToMove)
+		p.pockets[p.sideToMove][movingPiece.kind]--
+	} else {
+		movingPiece = p.pieceAtOrPanic(mov.Source())
+		if p.options.DebugChecks && movingPiece.color != p.sideToMove {
+			panic("moving a piece that does not belong to the moving player")
+		}
+
+		wasPromoted = p.promoted.Test(mov.Source())
+		p.promoted.Unset(mov.Source())
+		p.removePieceOrPanic(mov.Source())
+	}
+
 	if mov.IsCapture() {
 		p.applyCapture(mov)
 	}
 
+	// destination is the square that the moving piece ends up on, which
+	// for castles is not the move destination.
+	destination := mov.Destination()
 	if mov.IsCastle() {
-		p.applyCastle(mov)
+		destination = p.applyCastle(mov)
 	}
 
 	// pieceToAdd is the piece that will be added at the target square.
@@ -338,7 +639,11 @@ func (p *Position) ApplyMove(mov Move) {
 		pieceToAdd = MakePiece(mov.PromotionPiece(), movingPiece.color)
 	}
 
-	p.addPieceOrPanic(mov.Destination(), pieceToAdd)
+	p.addPieceOrPanic(destination, pieceToAdd)
+	if p.hasPockets() && (wasPromoted || mov.IsPromotion()) {
+		p.promoted.Set(destination)
+	}
+
 	if mov.IsDoublePawnPush() {
 		// double pawn pushes set the EP-square
 		var epDir Direction
@@ -353,12 +658,12 @@ func (p *Position) ApplyMove(mov Move) {
 		p.enPassantSquare = InvalidSquare
 	}
 
-	if p.CanCastleKingside(p.sideToMove) || p.CanCastleQueenside(p.sideToMove) {
+	if !mov.IsDrop() && (p.CanCastleKingside(p.sideToMove) || p.CanCastleQueenside(p.sideToMove)) {
 		p.updateCastleStatus(mov, movingPiece)
 	}
 
 	p.sideToMove = p.sideToMove.Toggle()
-	if mov.IsCapture() || movingPiece.kind == Pawn {
+	if mov.IsCapture() || (movingPiece.kind == Pawn && !mov.IsDrop()) {
 		p.halfmoveClock = 0
 	} else {
 		// not capturing or moving a pawn counts against the fifty
@@ -370,21 +675,71 @@ func (p *Position) ApplyMove(mov Move) {
 		// if it's white's turn to move again, a turn has ended.
 		p.fullmoveClock++
 	}
+
+	p.variant.AfterMove(p, mov)
+	if p.options.DebugChecks {
+		if err := p.CheckInvariants(); err != nil {
+			panic(fmt.Sprintf("ApplyMove(%s) broke an invariant: %s", mov.UciStringWithOptions(p.options), err))
+		}
+	}
 }
 
 // Clone performs a deep clone of this position, returning a new Position.
 func (p *Position) Clone() *Position {
-	newPos := MakeEmptyPosition()
-	copy(newPos.boardsByPiece[White], p.boardsByPiece[White])
-	copy(newPos.boardsByPiece[Black], p.boardsByPiece[Black])
-	copy(newPos.boardsByColor, p.boardsByColor)
+	newPos := *p
+	return &newPos
+}
+
+// Mirror returns a new position that is this one with the board flipped
+// vertically and the colors swapped: every white piece on a1 becomes a black
+// piece on a8, and so on. The castling rights, en passant square, side to
+// move and per-color variant state are swapped to match, so the mirrored
+// position is the same game seen from the other side of the board.
+//
+// Racing Kings and Horde treat the two colors differently, so mirroring their
+// positions doesn't produce an equivalent position.
+func (p *Position) Mirror() *Position {
+	mirror := *p
+	for _, color := range [...]Color{White, Black} {
+		for kind := Pawn; kind <= King; kind++ {
+			mirror.boardsByPiece[color][kind] = p.boardsByPiece[color.Toggle()][kind].FlipVertical()
+		}
+
+		mirror.boardsByColor[color] = p.boardsByColor[color.Toggle()].FlipVertical()
+		for side := range p.castleRooks[color] {
+			mirror.castleRooks[color][side] = mirrorSquare(p.castleRooks[color.Toggle()][side])
+		}
+
+		mirror.checksGiven[color] = p.checksGiven[color.Toggle()]
+		mirror.pockets[color] = p.pockets[color.Toggle()]
+	}
+
+	for square := A1; square <= H8; square++ {
+		mirror.mailbox[square] = MakeNullPiece()
+	}
+
+	for square := A1; square <= H8; square++ {
+		if piece, ok := p.PieceAt(square); ok {
+			mirror.mailbox[mirrorSquare(square)] = MakePiece(piece.kind, piece.color.Toggle())
+		}
+	}
+
+	if p.HasEnPassantSquare() {
+		mirror.enPassantSquare = mirrorSquare(p.enPassantSquare)
+	}
 
-	newPos.enPassantSquare = p.enPassantSquare
-	newPos.fullmoveClock = p.fullmoveClock
-	newPos.halfmoveClock = p.halfmoveClock
-	newPos.sideToMove = p.sideToMove
-	newPos.castleStatus = p.castleStatus
-	return newPos
+	mirror.castleStatus = (p.castleStatus&whiteCastleMask)<<2 | (p.castleStatus&blackCastleMask)>>2
+	mirror.sideToMove = p.sideToMove.Toggle()
+	mirror.promoted = p.promoted.FlipVertical()
+	return &mirror
+}
+
+// mirrorSquare returns the square on the same file as the given square and on
+// the opposite rank, e.g. a8 for a1.
+func mirrorSquare(square Square) Square {
+	// squares are numbered rank by rank, so flipping the bits of the rank
+	// flips the square.
+	return square ^ 56
 }
 
 // Subroutine for handling piece capture, since some additional checks
@@ -409,81 +764,49 @@ func (p *Position) applyCapture(mov Move) {
 		targetSquare = mov.Destination()
 	}
 
+	if p.hasPockets() {
+		p.pocketCapture(targetSquare)
+	}
+
 	p.removePieceOrPanic(targetSquare)
 
 	// if we are capturing a rook that has not moved from its initial
 	// state (i.e. the opponent could have used it to legally castle),
 	// we have to invalidate the opponent's castling rights.
 	opposingSide := p.sideToMove.Toggle()
-	if p.CanCastleKingside(opposingSide) {
-		var startingSquare Square
-		var castleFlag uint8
-		if opposingSide == White {
-			startingSquare = H1
-			castleFlag = whiteOO
-		} else {
-			startingSquare = H8
-			castleFlag = blackOO
-		}
-
-		if targetSquare == startingSquare {
-			// if the opponent can castle kingside and we just captured
-			// a piece on the kingside rook starting square, we must
-			// have just captured a rook.
-			//
-			// we must eliminate the kingside castle.
-			p.castleStatus &= ^castleFlag
-		}
+	if p.CanCastleKingside(opposingSide) && targetSquare == p.KingsideCastleRook(opposingSide) {
+		// if the opponent can castle kingside and we just captured
+		// a piece on the kingside rook starting square, we must
+		// have just captured a rook.
+		//
+		// we must eliminate the kingside castle.
+		p.castleStatus &= ^castleFlag(opposingSide, true)
 	}
 
 	// same deal for queenside castles.
-	if p.CanCastleQueenside(opposingSide) {
-		var startingSquare Square
-		var castleFlag uint8
-		if opposingSide == White {
-			startingSquare = A1
-			castleFlag = whiteOOO
-		} else {
-			startingSquare = A8
-			castleFlag = blackOOO
-		}
-
-		if targetSquare == startingSquare {
-			p.castleStatus &= ^castleFlag
-		}
+	if p.CanCastleQueenside(opposingSide) && targetSquare == p.QueensideCastleRook(opposingSide) {
+		p.castleStatus &= ^castleFlag(opposingSide, false)
 	}
 }
 
 // Subroutine for handling castling, since castle moves are encoded in a
 // unique way and are generally unique in chess in that they move two pieces
 // instead of one.
-func (p *Position) applyCastle(mov Move) {
-	// castles are encoded based on the king's start and stop position.
-	// notably, the rook is not at the move destination.
-
-	// regardless of how we are castling, the rook appears
-	// adjacent to the king.
-	var postCastleDir Direction
-	var preCastleDir Direction
-	var rookSquare Square
-	if mov.IsKingsideCastle() {
-		postCastleDir = West
-		preCastleDir = East
-		rookSquare = mov.Destination().Towards(preCastleDir)
-	} else {
-		postCastleDir = East
-		preCastleDir = West
-		rookSquare = mov.Destination().Towards(preCastleDir).Towards(preCastleDir)
-	}
-
-	newRookSquare := mov.Destination().Towards(postCastleDir)
-	rook := p.pieceAtOrPanic(rookSquare)
+//
+// castles are encoded as the king capturing its own rook, so the rook is at
+// the move destination. This moves the rook to its final square and returns
+// the square that the king should be placed on, which the caller has already
+// removed from the board.
+func (p *Position) applyCastle(mov Move) Square {
+	kingTarget, rookTarget := castleTargets(mov.Source().Rank(), mov.IsKingsideCastle())
+	rook := p.pieceAtOrPanic(mov.Destination())
 	if rook.kind != Rook {
 		panic("piece at rook castle square is not a rook")
 	}
 
-	p.removePieceOrPanic(rookSquare)
-	p.addPieceOrPanic(newRookSquare, rook)
+	p.removePieceOrPanic(mov.Destination())
+	p.addPieceOrPanic(rookTarget, rook)
+	return kingTarget
 }
 
 // Subroutine for updating the castle status if any player can still castle.
@@ -494,20 +817,11 @@ func (p *Position) updateCastleStatus(mov Move, movingPiece Piece) {
 		// either direction anymore.
 		p.clearCastleStatus()
 	case Rook:
-		var kingsideRook, queensideRook Square
-		if p.sideToMove == White {
-			kingsideRook = H1
-			queensideRook = A1
-		} else {
-			kingsideRook = H8
-			queensideRook = A8
-		}
-
-		if p.CanCastleQueenside(p.sideToMove) && mov.Source() == queensideRook {
+		if p.CanCastleQueenside(p.sideToMove) && mov.Source() == p.QueensideCastleRook(p.sideToMove) {
 			p.clearQueensideCastle()
 		}
 
-		if p.CanCastleKingside(p.sideToMove) && mov.Source() == kingsideRook {
+		if p.CanCastleKingside(p.sideToMove) && mov.Source() == p.KingsideCastleRook(p.sideToMove) {
 			p.clearKingsideCastle()
 		}
 	default:
@@ -547,25 +861,11 @@ func (p *Position) clearCastleStatus() {
 }
 
 func (p *Position) clearKingsideCastle() {
-	var mask uint8
-	if p.sideToMove == White {
-		mask = whiteOO
-	} else {
-		mask = blackOO
-	}
-
-	p.castleStatus &= ^mask
+	p.castleStatus &= ^castleFlag(p.sideToMove, true)
 }
 
 func (p *Position) clearQueensideCastle() {
-	var mask uint8
-	if p.sideToMove == White {
-		mask = whiteOOO
-	} else {
-		mask = blackOOO
-	}
-
-	p.castleStatus &= ^mask
+	p.castleStatus &= ^castleFlag(p.sideToMove, false)
 }
 
 func (p *Position) String() string {
@@ -611,3 +911,68 @@ const (
 	blackOOO        = 0x8
 	blackCastleMask = 0xC
 )
+
+// Indices into the second dimension of a Position's castleRooks.
+const (
+	castleKingside  = 0
+	castleQueenside = 1
+)
+
+// castleFlag returns the castleStatus flag for the given color and side.
+func castleFlag(color Color, kingside bool) uint8 {
+	switch {
+	case color == White && kingside:
+		return whiteOO
+	case color == White:
+		return whiteOOO
+	case kingside:
+		return blackOO
+	}
+
+	return blackOOO
+}
+
+// castleSide returns the index into castleRooks for the given side.
+func castleSide(kingside bool) int {
+	if kingside {
+		return castleKingside
+	}
+
+	return castleQueenside
+}
+
+// castleTargets returns the squares that the king and rook end up on after
+// castling on the given rank. These are the same in Chess960 as they are in
+// standard chess, regardless of where the king and rook started.
+func castleTargets(rank Rank, kingside bool) (king, rook Square) {
+	if kingside {
+		return MakeSquare(rank, FileG), MakeSquare(rank, FileF)
+	}
+
+	return MakeSquare(rank, FileC), MakeSquare(rank, FileD)
+}
+
+// backRank returns the rank that the given color's pieces start on.
+func backRank(color Color) Rank {
+	if color == White {
+		return Rank1
+	}
+
+	return Rank8
+}
+
+// rankSpan returns a bitboard of the squares between the two given squares on
+// the same rank, including the squares themselves.
+func rankSpan(a, b Square) Bitboard {
+	low, high := a.File(), b.File()
+	if low > high {
+		low, high = high, low
+	}
+
+	span := EmptyBitboard
+	for file := low; file <= high; file++ {
+		span.Set(MakeSquare(a.Rank(), file))
+	}
+
+	return span
+}
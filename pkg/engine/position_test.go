@@ -12,7 +12,7 @@ func TestMoveApplication(t *testing.T) {
 		pos := MakeDefaultPosition()
 
 		// nothing fancy, move a pawn up one.
-		pos.ApplyMove(MakeQuietMove(E2, E3))
+		applyUciMoves(tt, pos, "e2e3")
 
 		// it should now be Black's turn to move.
 		assert.Equal(tt, Black, pos.SideToMove())
@@ -232,7 +232,7 @@ func TestMoveApplication(t *testing.T) {
 		}
 
 		// white to move, white castles queenside
-		pos.ApplyMove(MakeQueensideCastleMove(E1, C1))
+		pos.ApplyMove(MakeQueensideCastleMove(E1, A1))
 
 		rook, ok := pos.PieceAt(D1)
 		if !assert.True(tt, ok) {
@@ -258,7 +258,7 @@ func TestMoveApplication(t *testing.T) {
 		}
 
 		// white to move, white castles kingside
-		pos.ApplyMove(MakeKingsideCastleMove(E1, G1))
+		pos.ApplyMove(MakeKingsideCastleMove(E1, H1))
 
 		rook, ok := pos.PieceAt(F1)
 		if !assert.True(tt, ok) {
@@ -277,3 +277,209 @@ func TestMoveApplication(t *testing.T) {
 		assert.Equal(tt, King, king.kind)
 	})
 }
+
+// This test counts allocations, so it can't run in parallel with the other
+// tests.
+func TestCloneIsIndependent(t *testing.T) {
+	pos := MakeDefaultPosition()
+	clone := pos.Clone()
+	clone.ApplyMove(MakeDoublePawnPushMove(E2, E4))
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", pos.AsFen())
+	assert.Equal(t, "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1", clone.AsFen())
+
+	// copying a position makes at most one allocation, for the copy itself.
+	allocs := testing.AllocsPerRun(100, func() {
+		clone = pos.Clone()
+	})
+
+	assert.True(t, allocs <= 1, "Clone made %v allocations", allocs)
+}
+
+func TestMailboxMatchesBitboards(t *testing.T) {
+	t.Parallel()
+	pos, err := MakePositionFromFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// play every line two plies deep, which includes captures, castles and
+	// en passant, and compare every square afterwards.
+	for _, first := range pos.LegalMoves() {
+		afterFirst := pos.Clone()
+		afterFirst.ApplyMove(first)
+		for _, second := range afterFirst.LegalMoves() {
+			afterSecond := afterFirst.Clone()
+			afterSecond.ApplyMove(second)
+			occupied := afterSecond.White() | afterSecond.Black()
+			for square := A1; square <= H8; square++ {
+				piece, ok := afterSecond.PieceAt(square)
+				if !assert.Equal(t, occupied.Test(square), ok, "%s %s %s", first, second, square) {
+					t.FailNow()
+				}
+
+				if ok && !assert.Equal(t, afterSecond.pieceAtFromBitboards(square), piece, "%s %s %s", first, second, square) {
+					t.FailNow()
+				}
+			}
+		}
+	}
+}
+
+// This test isn't parallel, since it turns on the global debug checks.
+func TestMailboxDebugCheck(t *testing.T) {
+	options.DebugChecks = true
+	defer func() { options.DebugChecks = false }()
+
+	pos := MakeDefaultPosition()
+	piece, ok := pos.PieceAt(D1)
+	assert.True(t, ok)
+	assert.Equal(t, MakePiece(Queen, White), piece)
+
+	pos.mailbox[D1] = MakePiece(King, White)
+	assert.Panics(t, func() { pos.PieceAt(D1) })
+}
+
+// This test isn't parallel, since it toggles the global debug checks.
+func TestPositionOptionsOverrideGlobal(t *testing.T) {
+	defer SetDebugChecks(false)
+
+	// a position's own options decide whether it runs the debug checks,
+	// whatever the global options say.
+	SetDebugChecks(true)
+	unchecked := MakeDefaultPosition()
+	assert.True(t, unchecked.Options().DebugChecks)
+	unchecked.SetOptions(DefaultOptions())
+	unchecked.mailbox[D1] = MakePiece(King, White)
+	assert.NotPanics(t, func() { unchecked.PieceAt(D1) })
+	assert.NotPanics(t, func() { unchecked.Clone().ApplyMove(MakeDoublePawnPushMove(E2, E4)) })
+
+	SetDebugChecks(false)
+	checked := MakeDefaultPosition()
+	assert.False(t, checked.Options().DebugChecks)
+	opts := DefaultOptions()
+	opts.DebugChecks = true
+	checked.SetOptions(opts)
+	checked.mailbox[D1] = MakePiece(King, White)
+	assert.Panics(t, func() { checked.PieceAt(D1) })
+	assert.Panics(t, func() { checked.Clone().ApplyMove(MakeDoublePawnPushMove(E2, E4)) })
+}
+
+// Copying positions is the most common operation in perft and search.
+func BenchmarkClone(b *testing.B) {
+	b.ReportAllocs()
+	pos := MakeDefaultPosition()
+	for i := 0; i < b.N; i++ {
+		pos = pos.Clone()
+	}
+}
+
+func TestAttackQueries(t *testing.T) {
+	t.Parallel()
+	t.Run("checkers", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/5n2/8/R3K2r w - - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		expected := EmptyBitboard
+		expected.Set(F3)
+		expected.Set(H1)
+		assert.Equal(tt, expected, pos.Checkers())
+	})
+
+	t.Run("no-checkers", func(tt *testing.T) {
+		pos := MakeDefaultPosition()
+		assert.Equal(tt, EmptyBitboard, pos.Checkers())
+	})
+
+	t.Run("pinned", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("k3r3/1p6/8/8/1b2B2b/8/3N1P2/4K3 w - - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		white := EmptyBitboard
+		white.Set(D2)
+		white.Set(E4)
+		white.Set(F2)
+		assert.Equal(tt, white, pos.Pinned(White))
+		black := EmptyBitboard
+		black.Set(B7)
+		assert.Equal(tt, black, pos.Pinned(Black))
+	})
+
+	t.Run("two-blockers-are-not-pinned", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4r2k/8/8/8/4N3/4B3/8/4K3 w - - 0 1")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, EmptyBitboard, pos.Pinned(White))
+	})
+
+	// every square attacked according to AttackedSquares should have at
+	// least one attacker according to SquaresAttacking, and vice versa.
+	for _, fen := range [...]string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+	} {
+		fen := fen
+		t.Run("attacked-squares-"+fen, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(fen)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			for _, color := range [...]Color{White, Black} {
+				attacked := pos.AttackedSquares(color)
+				for square := A1; square <= H8; square++ {
+					assert.Equal(tt, !pos.SquaresAttacking(color, square).Empty(), attacked.Test(square), "%s %s", color, square)
+				}
+			}
+		})
+	}
+}
+
+func TestMirror(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name     string
+		fen      string
+		mirrored string
+		opts     []FenOption
+	}{
+		{"start",
+			"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+			"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR b KQkq - 0 1", nil},
+		{"en-passant",
+			"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1",
+			"rnbqkbnr/pppp1ppp/8/4p3/8/8/PPPPPPPP/RNBQKBNR w KQkq e6 0 1", nil},
+		{"castling-rights",
+			"r3k2r/8/8/8/8/8/8/R3K2R w Kq - 3 20",
+			"r3k2r/8/8/8/8/8/8/R3K2R b Qk - 3 20", nil},
+		{"chess960",
+			"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w KQkq - 2 9",
+			"bq1bnrkr/npp1p1pp/p2p4/5p2/2P5/3PPN2/PP3PPP/BQNB1RKR b KQkq - 2 9", []FenOption{FenChess960()}},
+		{"crazyhouse",
+			"4k3/1Q~6/8/8/4b3/8/Kpp5/8[Nbp] b - - 0 1",
+			"8/kPP5/8/4B3/8/8/1q~6/4K3[BPn] w - - 0 1", []FenOption{FenVariant(Crazyhouse)}},
+		{"three-check",
+			"r1bqkbnr/pppp1ppp/2n5/4p3/2B1P3/5Q2/PPPP1PPP/RNB1K1NR w KQkq - 1+2 4 4",
+			"rnb1k1nr/pppp1ppp/5q2/2b1p3/4P3/2N5/PPPP1PPP/R1BQKBNR b KQkq - 2+1 4 4", []FenOption{FenVariant(ThreeCheck)}},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, test.opts...)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			mirror := pos.Mirror()
+			assert.Equal(tt, test.mirrored, mirror.AsFen())
+			assert.NoError(tt, mirror.CheckInvariants())
+			assert.Equal(tt, pos, mirror.Mirror())
+			assert.Equal(tt, test.fen, pos.AsFen(), "Mirror changed the original position")
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package engine
+
+// RacingKings is the variant in which each side races its king to the eighth
+// rank. Neither king may ever be in check, so moves that give check are
+// illegal as well as moves that leave the mover in check. Since White moves
+// first, a game in which White's king reaches the eighth rank continues for
+// one more move if Black's king can reach it too, which draws.
+var RacingKings Variant = racingKings{}
+
+type racingKings struct {
+	standard
+}
+
+// the goal: the eighth rank.
+var racingKingsGoal = FullBitboard.Rank(Rank8)
+
+func (racingKings) Name() string {
+	return "racingkings"
+}
+
+// LegalMoves filters out the moves that leave either king in check.
+func (racingKings) LegalMoves(pos *Position, list *MoveList, scratch *Position) {
+	list.Filter(func(mov Move) bool {
+		*scratch = *pos
+		scratch.ApplyMove(mov)
+		return !scratch.IsCheck(White) && !scratch.IsCheck(Black)
+	})
+}
+
+func (racingKings) VariantOutcome(pos *Position) (Result, bool) {
+	white := pos.Kings(White)&racingKingsGoal != 0
+	black := pos.Kings(Black)&racingKingsGoal != 0
+	switch {
+	case white && black:
+		return Draw, true
+	case black:
+		return BlackWins, true
+	case white:
+		if pos.SideToMove() == Black && blackCanReachGoal(pos) {
+			return Draw, false
+		}
+
+		return WhiteWins, true
+	}
+
+	return Draw, false
+}
+
+// blackCanReachGoal returns whether or not Black's king can move to a square
+// on the eighth rank that isn't attacked by White.
+func blackCanReachGoal(pos *Position) bool {
+	kings := pos.Kings(Black).Iter()
+	for king, next := kings.Next(); next; king, next = kings.Next() {
+		targets := (KingAttacks(king) & racingKingsGoal &^ pos.Black()).Iter()
+		for target, next := targets.Next(); next; target, next = targets.Next() {
+			if pos.SquaresAttacking(White, target).Empty() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,186 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// This file provides functions for converting moves to-and-from Standard
+// Algebraic Notation (SAN), the notation used by humans, PGN and EPD.
+
+var SanSyntaxError = errors.New("malformed SAN move")
+var SanNoSuchMoveError = errors.New("SAN move is not legal in this position")
+var SanAmbiguousMoveError = errors.New("SAN move is ambiguous in this position")
+
+// piece letter, source file, source rank, capture, destination, promotion.
+var sanPattern = regexp.MustCompile(`^([NBRQK])?([a-h])?([1-8])?(x)?([a-h][1-8])(?:=?([NBRQ]))?$`)
+
+// piece letter and destination of a Crazyhouse drop.
+var sanDropPattern = regexp.MustCompile(`^([PNBRQ])?@([a-h][1-8])$`)
+
+// ParseSanMove resolves a move in SAN to the legal move that it describes
+// in this position. Check and annotation suffixes ("+", "#", "!", "?") are
+// ignored, castling may be written with either the letter O or the digit 0,
+// and the "=" before a promotion piece is optional. Drops are written as the
+// piece letter, an "@" and the destination square; the letter may be left
+// off for pawns.
+func (p *Position) ParseSanMove(san string) (Move, error) {
+	trimmed := strings.TrimRight(san, "+#!?")
+	switch trimmed {
+	case "O-O", "0-0":
+		return p.findSanMove(san, func(mov Move) bool { return mov.IsKingsideCastle() })
+	case "O-O-O", "0-0-0":
+		return p.findSanMove(san, func(mov Move) bool { return mov.IsQueensideCastle() })
+	}
+
+	if groups := sanDropPattern.FindStringSubmatch(trimmed); groups != nil {
+		kind := Pawn
+		if groups[1] != "" {
+			piece, _ := MakePieceFromRune([]rune(groups[1])[0])
+			kind = piece.kind
+		}
+
+		dest, _ := MakeSquareFromString(groups[2])
+		return p.findSanMove(san, func(mov Move) bool { return mov == MakeDropMove(kind, dest) })
+	}
+
+	groups := sanPattern.FindStringSubmatch(trimmed)
+	if groups == nil {
+		return Move(0), fmt.Errorf("%w: `%s`", SanSyntaxError, san)
+	}
+
+	kind := Pawn
+	if groups[1] != "" {
+		piece, _ := MakePieceFromRune([]rune(groups[1])[0])
+		kind = piece.kind
+	}
+
+	dest, _ := MakeSquareFromString(groups[5])
+	return p.findSanMove(san, func(mov Move) bool {
+		if mov.IsCastle() || mov.IsDrop() || mov.Destination() != dest {
+			return false
+		}
+
+		piece, _ := p.PieceAt(mov.Source())
+		if piece.kind != kind {
+			return false
+		}
+
+		if groups[2] != "" && mov.Source().File().String() != groups[2] {
+			return false
+		}
+
+		if groups[3] != "" && mov.Source().Rank().String() != groups[3] {
+			return false
+		}
+
+		if groups[4] != "" && !mov.IsCapture() {
+			return false
+		}
+
+		if groups[6] == "" {
+			return !mov.IsPromotion()
+		}
+
+		return mov.IsPromotion() && strings.ToUpper(mov.PromotionPiece().String()) == groups[6]
+	})
+}
+
+// findSanMove returns the single legal move that satisfies the given
+// predicate.
+func (p *Position) findSanMove(san string, matches func(Move) bool) (Move, error) {
+	var found []Move
+	for _, mov := range p.LegalMoves() {
+		if matches(mov) {
+			found = append(found, mov)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return Move(0), fmt.Errorf("%w: `%s`", SanNoSuchMoveError, san)
+	case 1:
+		return found[0], nil
+	}
+
+	return Move(0), fmt.Errorf("%w: `%s`", SanAmbiguousMoveError, san)
+}
+
+// SanString returns the SAN representation of the given legal move in this
+// position, including a "+" or "#" suffix if the move gives check or mate.
+func (p *Position) SanString(mov Move) string {
+	buf := new(bytes.Buffer)
+	var piece Piece
+	if !mov.IsDrop() {
+		piece = p.pieceAtOrPanic(mov.Source())
+	}
+
+	switch {
+	case mov.IsDrop():
+		fmt.Fprint(buf, mov.UciString())
+	case mov.IsKingsideCastle():
+		fmt.Fprint(buf, "O-O")
+	case mov.IsQueensideCastle():
+		fmt.Fprint(buf, "O-O-O")
+	case piece.kind == Pawn:
+		if mov.IsCapture() {
+			fmt.Fprintf(buf, "%sx", mov.Source().File())
+		}
+
+		fmt.Fprint(buf, mov.Destination())
+		if mov.IsPromotion() {
+			fmt.Fprintf(buf, "=%s", strings.ToUpper(mov.PromotionPiece().String()))
+		}
+	default:
+		fmt.Fprint(buf, strings.ToUpper(piece.kind.String()))
+
+		// if another piece of the same kind can move to the same square,
+		// the source square has to be disambiguated: by file if that's
+		// enough, otherwise by rank if that's enough, otherwise by both.
+		ambiguous, sameFile, sameRank := false, false, false
+		for _, other := range p.LegalMoves() {
+			if other == mov || other.Destination() != mov.Destination() || other.IsCastle() || other.IsDrop() {
+				continue
+			}
+
+			if otherPiece, _ := p.PieceAt(other.Source()); otherPiece != piece {
+				continue
+			}
+
+			ambiguous = true
+			sameFile = sameFile || other.Source().File() == mov.Source().File()
+			sameRank = sameRank || other.Source().Rank() == mov.Source().Rank()
+		}
+
+		if ambiguous {
+			if !sameFile {
+				fmt.Fprint(buf, mov.Source().File())
+			} else if !sameRank {
+				fmt.Fprint(buf, mov.Source().Rank())
+			} else {
+				fmt.Fprint(buf, mov.Source())
+			}
+		}
+
+		if mov.IsCapture() {
+			fmt.Fprint(buf, "x")
+		}
+
+		fmt.Fprint(buf, mov.Destination())
+	}
+
+	newPos := p.Clone()
+	newPos.ApplyMove(mov)
+	if newPos.IsCheck(newPos.SideToMove()) {
+		if len(newPos.LegalMoves()) == 0 {
+			fmt.Fprint(buf, "#")
+		} else {
+			fmt.Fprint(buf, "+")
+		}
+	}
+
+	return buf.String()
+}
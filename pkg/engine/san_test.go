@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sanTests = [...]struct {
+	fen string
+	san string
+	mov Move
+}{
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "e4", MakeDoublePawnPushMove(E2, E4)},
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", "Nf3", MakeQuietMove(G1, F3)},
+	{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "O-O", MakeKingsideCastleMove(E1, H1)},
+	{"r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1", "O-O-O", MakeQueensideCastleMove(E8, A8)},
+	{"4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", "exd6", MakeEnPassantMove(E5, D6)},
+	{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=Q+", MakePromotionMove(B7, B8, Queen)},
+	{"n3k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "bxa8=N", MakePromotionCaptureMove(B7, A8, Knight)},
+	{"4k3/8/8/8/8/8/8/R4RK1 w - - 0 1", "Rad1", MakeQuietMove(A1, D1)},
+	{"4k3/8/8/8/8/R7/8/R3K3 w - - 0 1", "R1a2", MakeQuietMove(A1, A2)},
+	{"1k6/8/8/8/4Q2Q/2K5/8/7Q w - - 0 1", "Qh4e1", MakeQuietMove(H4, E1)},
+	{"6k1/5ppp/8/8/8/8/8/R3K3 w - - 0 1", "Ra8#", MakeQuietMove(A1, A8)},
+}
+
+func TestSan(t *testing.T) {
+	t.Parallel()
+	for _, test := range sanTests {
+		test := test
+		t.Run(test.san, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			mov, err := pos.ParseSanMove(test.san)
+			if assert.NoError(tt, err) {
+				assert.Equal(tt, test.mov, mov)
+			}
+
+			assert.Equal(tt, test.san, pos.SanString(test.mov))
+		})
+	}
+}
+
+func TestSanErrors(t *testing.T) {
+	t.Parallel()
+	pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/R4RK1 w - - 0 1")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	_, err = pos.ParseSanMove("Rd1")
+	assert.True(t, errors.Is(err, SanAmbiguousMoveError))
+
+	_, err = pos.ParseSanMove("Nf3")
+	assert.True(t, errors.Is(err, SanNoSuchMoveError))
+
+	_, err = pos.ParseSanMove("Zz9")
+	assert.True(t, errors.Is(err, SanSyntaxError))
+
+	// lenient spellings are accepted.
+	pos, err = MakePositionFromFen("4k3/8/8/8/8/8/8/4K2R w K - 0 1")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	mov, err := pos.ParseSanMove("0-0")
+	assert.NoError(t, err)
+	assert.Equal(t, MakeKingsideCastleMove(E1, H1), mov)
+}
@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ThreeCheckInvalidChecksError = errors.New("invalid remaining checks in three-check FEN")
+
+// ThreeCheck is the variant in which, in addition to checkmate, a side wins
+// by checking the opposing king three times.
+//
+// Its FEN has an extra field after the en-passant square giving the number of
+// checks that White and Black have left to give, e.g. "3+3" at the start of
+// the game.
+var ThreeCheck FenExtension = threeCheck{}
+
+// the number of checks that win the game.
+const threeCheckLimit = 3
+
+type threeCheck struct {
+	standard
+}
+
+func (threeCheck) Name() string {
+	return "3check"
+}
+
+func (threeCheck) VariantOutcome(pos *Position) (Result, bool) {
+	for _, color := range [...]Color{White, Black} {
+		if pos.checksGiven[color] >= threeCheckLimit {
+			return WinFor(color), true
+		}
+	}
+
+	return Draw, false
+}
+
+// AfterMove counts the check, if the move that was just played gave one.
+func (threeCheck) AfterMove(pos *Position, mov Move) {
+	if pos.IsCheck(pos.SideToMove()) {
+		pos.checksGiven[pos.SideToMove().Toggle()]++
+	}
+}
+
+func (threeCheck) ParseFenField(pos *Position, field string) error {
+	parts := strings.Split(field, "+")
+	if len(parts) != 2 {
+		return fmt.Errorf("%w: `%s`", ThreeCheckInvalidChecksError, field)
+	}
+
+	for i, color := range [...]Color{White, Black} {
+		remaining, err := strconv.Atoi(parts[i])
+		if err != nil || remaining < 0 || remaining > threeCheckLimit {
+			return fmt.Errorf("%w: `%s`", ThreeCheckInvalidChecksError, field)
+		}
+
+		pos.checksGiven[color] = uint8(threeCheckLimit - remaining)
+	}
+
+	return nil
+}
+
+func (threeCheck) FenField(pos *Position) string {
+	return fmt.Sprintf("%d+%d",
+		threeCheckLimit-int(pos.checksGiven[White]),
+		threeCheckLimit-int(pos.checksGiven[Black]))
+}
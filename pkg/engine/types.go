@@ -267,6 +267,14 @@ func (c Color) Toggle() Color {
 	return White
 }
 
+func (c Color) String() string {
+	if c == White {
+		return "white"
+	}
+
+	return "black"
+}
+
 // A PieceKind represents a kind of piece on the chessboard.
 type PieceKind uint8
 
@@ -335,6 +343,11 @@ func (d Direction) AsVector() int64 {
 	panic("unknown direction")
 }
 
+// Opposite returns the direction pointing the opposite way of this one.
+func (d Direction) Opposite() Direction {
+	return (d + 4) % 8
+}
+
 // A Piece is specific piece kind that belongs to a particular player.
 type Piece struct {
 	kind  PieceKind
@@ -385,6 +398,16 @@ func MakeNullPiece() Piece {
 	return Piece{0, 0}
 }
 
+// Kind returns the kind of this piece.
+func (p Piece) Kind() PieceKind {
+	return p.kind
+}
+
+// Color returns the color of the player that owns this piece.
+func (p Piece) Color() Color {
+	return p.color
+}
+
 func (p Piece) String() string {
 	pieceStr := p.kind.String()
 	if p.color == White {
@@ -0,0 +1,129 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// This file provides functions for converting moves from the long algebraic
+// notation used by the UCI protocol, the inverse of Move.UciString.
+
+var UciSyntaxError = errors.New("malformed UCI move")
+var UciIllegalMoveError = errors.New("UCI move is not legal in this position")
+
+// source, destination, promotion.
+var uciPattern = regexp.MustCompile(`^([a-h][1-8])([a-h][1-8])([nbrq])?$`)
+
+// piece letter and destination of a Crazyhouse drop.
+var uciDropPattern = regexp.MustCompile(`^([PNBRQ])@([a-h][1-8])$`)
+
+// ParseUciMove resolves a move in UCI notation to the legal move that it
+// describes in this position, working out from the position whether it is a
+// capture, a double pawn push, an en-passant capture or a castle. Castles are
+// accepted both as the king moving to its destination square and as the king
+// capturing its own rook, whatever the UCI_Chess960 option is set to, except
+// that a Chess960 castle whose king destination a king move could also reach
+// is read as that king move. Moves that aren't legal are rejected with an
+// error that says why.
+func (p *Position) ParseUciMove(uci string) (Move, error) {
+	if !uciPattern.MatchString(uci) && !uciDropPattern.MatchString(uci) {
+		return Move(0), fmt.Errorf("%w: `%s`", UciSyntaxError, uci)
+	}
+
+	if mov, ok := findUciMove(p.LegalMoves(), uci); ok {
+		return mov, nil
+	}
+
+	if _, over := p.Outcome(); over {
+		return Move(0), illegalUciMove(uci, "the game is over")
+	}
+
+	mov, ok := findUciMove(p.PseudolegalMoves(), uci)
+	if !ok {
+		return Move(0), illegalUciMove(uci, p.unreachableReason(uci))
+	}
+
+	newPos := p.Clone()
+	newPos.ApplyMove(mov)
+	if newPos.IsCheck(p.SideToMove()) {
+		return Move(0), illegalUciMove(uci, "it leaves the king in check")
+	}
+
+	return Move(0), illegalUciMove(uci, fmt.Sprintf("it is against the rules of %s", p.variant.Name()))
+}
+
+// findUciMove returns the move among the given ones that is written as the
+// given UCI string. Castles match in either notation.
+//
+// In Chess960 the king's destination can be a square that the king could also
+// step to: with the king on f1 and the rook on h1, "f1g1" is both a king move
+// and a castle. The king move wins, so a Chess960 castle like that one has to
+// be written as the king capturing its own rook, which nothing else can be.
+func findUciMove(moves []Move, uci string) (Move, bool) {
+	castle, found := Move(0), false
+	for _, mov := range moves {
+		if !mov.IsCastle() {
+			if mov.UciString() == uci {
+				return mov, true
+			}
+
+			continue
+		}
+
+		kingTarget, _ := castleTargets(mov.Source().Rank(), mov.IsKingsideCastle())
+		if uci == mov.Source().String()+kingTarget.String() || uci == mov.Source().String()+mov.Destination().String() {
+			castle, found = mov, true
+		}
+	}
+
+	return castle, found
+}
+
+// unreachableReason explains why a well-formed UCI move matches none of the
+// pseudo-legal moves in this position.
+func (p *Position) unreachableReason(uci string) string {
+	if groups := uciDropPattern.FindStringSubmatch(uci); groups != nil {
+		if !p.hasPockets() {
+			return fmt.Sprintf("there are no drops in %s", p.variant.Name())
+		}
+
+		piece, _ := MakePieceFromRune([]rune(groups[1])[0])
+		if p.Pocket(p.SideToMove(), piece.kind) == 0 {
+			return fmt.Sprintf("%s has no %s to drop", p.SideToMove(), groups[1])
+		}
+
+		return fmt.Sprintf("%s can't be dropped on %s", groups[1], groups[2])
+	}
+
+	groups := uciPattern.FindStringSubmatch(uci)
+	source, _ := MakeSquareFromString(groups[1])
+	dest, _ := MakeSquareFromString(groups[2])
+	piece, ok := p.PieceAt(source)
+	if !ok {
+		return fmt.Sprintf("there is no piece on %s", source)
+	}
+
+	if piece.color != p.SideToMove() {
+		return fmt.Sprintf("the piece on %s is %s's, but it is %s's move", source, piece.color, p.SideToMove())
+	}
+
+	for _, mov := range p.PseudolegalMoves() {
+		if mov.IsDrop() || mov.Source() != source || mov.Destination() != dest {
+			continue
+		}
+
+		if mov.IsPromotion() {
+			return fmt.Sprintf("a pawn moving to %s must promote", dest)
+		}
+
+		return fmt.Sprintf("the move from %s to %s is not a promotion", source, dest)
+	}
+
+	return fmt.Sprintf("the %s on %s can't move to %s", strings.ToUpper(piece.kind.String()), source, dest)
+}
+
+func illegalUciMove(uci, reason string) error {
+	return fmt.Errorf("%w: `%s`: %s", UciIllegalMoveError, uci, reason)
+}
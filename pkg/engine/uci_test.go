@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var uciTests = [...]struct {
+	name string
+	fen  string
+	opts []FenOption
+	uci  string
+	mov  Move
+}{
+	{"quiet", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", nil, "g1f3", MakeQuietMove(G1, F3)},
+	{"double-push", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", nil, "e2e4", MakeDoublePawnPushMove(E2, E4)},
+	{"capture", "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq d6 0 2", nil, "e4d5", MakeCaptureMove(E4, D5)},
+	{"en-passant", "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", nil, "e5d6", MakeEnPassantMove(E5, D6)},
+	{"promotion", "4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", nil, "b7b8q", MakePromotionMove(B7, B8, Queen)},
+	{"promotion-capture", "n3k3/1P6/8/8/8/8/8/4K3 w - - 0 1", nil, "b7a8n", MakePromotionCaptureMove(B7, A8, Knight)},
+	{"kingside-castle", "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", nil, "e1g1", MakeKingsideCastleMove(E1, H1)},
+	{"queenside-castle", "r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1", nil, "e8c8", MakeQueensideCastleMove(E8, A8)},
+	{"castle-king-takes-rook", "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", nil, "e1h1", MakeKingsideCastleMove(E1, H1)},
+	{"chess960-castle", "1r2k1r1/8/8/8/8/8/8/1R2K1R1 w GBgb - 0 1", []FenOption{FenChess960()}, "e1b1", MakeQueensideCastleMove(E1, B1)},
+	{"chess960-king-move-over-castle", "4k3/8/8/8/8/8/8/5K1R w H - 0 1", []FenOption{FenChess960()}, "f1g1", MakeQuietMove(F1, G1)},
+	{"chess960-castle-over-king-move", "4k3/8/8/8/8/8/8/5K1R w H - 0 1", []FenOption{FenChess960()}, "f1h1", MakeKingsideCastleMove(F1, H1)},
+	{"drop", "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1", []FenOption{FenVariant(Crazyhouse)}, "N@f3", MakeDropMove(Knight, F3)},
+}
+
+func TestUci(t *testing.T) {
+	t.Parallel()
+	for _, test := range uciTests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, test.opts...)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			mov, err := pos.ParseUciMove(test.uci)
+			if assert.NoError(tt, err) {
+				assert.Equal(tt, test.mov, mov)
+			}
+		})
+	}
+}
+
+func TestUciErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name     string
+		fen      string
+		opts     []FenOption
+		uci      string
+		sentinel error
+		message  string
+	}{
+		{"empty", "4k3/8/8/8/8/8/8/4K3 w - - 0 1", nil, "", UciSyntaxError, "malformed UCI move: ``"},
+		{"garbage", "4k3/8/8/8/8/8/8/4K3 w - - 0 1", nil, "e2-e4", UciSyntaxError, "malformed UCI move: `e2-e4`"},
+		{"bad-promotion-piece", "4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", nil, "b7b8k", UciSyntaxError, "malformed UCI move: `b7b8k`"},
+		{"empty-square", "4k3/8/8/8/8/8/8/4K3 w - - 0 1", nil, "e2e4",
+			UciIllegalMoveError, "UCI move is not legal in this position: `e2e4`: there is no piece on e2"},
+		{"wrong-side", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", nil, "e7e5",
+			UciIllegalMoveError, "UCI move is not legal in this position: `e7e5`: the piece on e7 is black's, but it is white's move"},
+		{"unreachable", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", nil, "b1b3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `b1b3`: the N on b1 can't move to b3"},
+		{"missing-promotion", "4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", nil, "b7b8",
+			UciIllegalMoveError, "UCI move is not legal in this position: `b7b8`: a pawn moving to b8 must promote"},
+		{"not-a-promotion", "4k3/8/1P6/8/8/8/8/4K3 w - - 0 1", nil, "b6b7q",
+			UciIllegalMoveError, "UCI move is not legal in this position: `b6b7q`: the move from b6 to b7 is not a promotion"},
+		{"self-check", "4k3/4r3/8/8/8/8/4B3/4K3 w - - 0 1", nil, "e2d3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `e2d3`: it leaves the king in check"},
+		{"castle-through-check", "4k3/8/8/8/8/8/5r2/R3K2R w KQ - 0 1", nil, "e1g1",
+			UciIllegalMoveError, "UCI move is not legal in this position: `e1g1`: the K on e1 can't move to g1"},
+		{"game-over", "rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", nil, "a2a3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `a2a3`: the game is over"},
+		{"variant-rules", "4k3/8/8/8/8/8/p7/R3K3 w - - 0 1", []FenOption{FenVariant(Antichess)}, "e1e2",
+			UciIllegalMoveError, "UCI move is not legal in this position: `e1e2`: it is against the rules of antichess"},
+		{"no-drops", "4k3/8/8/8/8/8/8/4K3 w - - 0 1", nil, "N@f3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `N@f3`: there are no drops in chess"},
+		{"empty-pocket", "4k3/8/8/8/8/8/8/4K3[n] w - - 0 1", []FenOption{FenVariant(Crazyhouse)}, "N@f3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `N@f3`: white has no N to drop"},
+		{"drop-on-piece", "4k3/8/8/8/8/5P2/8/4K3[N] w - - 0 1", []FenOption{FenVariant(Crazyhouse)}, "N@f3",
+			UciIllegalMoveError, "UCI move is not legal in this position: `N@f3`: N can't be dropped on f3"},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, append([]FenOption{FenLenient()}, test.opts...)...)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			_, err = pos.ParseUciMove(test.uci)
+			if assert.Error(tt, err) {
+				assert.True(tt, errors.Is(err, test.sentinel))
+				assert.Equal(tt, test.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestUciRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, fen := range [...]string{
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+		"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+		"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8",
+		"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9",
+	} {
+		pos, err := MakePositionFromFen(fen)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		for _, chess960 := range [...]bool{false, true} {
+			opts := DefaultOptions()
+			opts.UciChess960 = chess960
+			for _, mov := range pos.LegalMoves() {
+				parsed, err := pos.ParseUciMove(mov.UciStringWithOptions(opts))
+				if assert.NoError(t, err, "%s in %s", mov, fen) {
+					assert.Equal(t, mov, parsed, "%s in %s", mov, fen)
+				}
+			}
+		}
+	}
+}
+
+// applyUciMoves plays the given moves, written in UCI notation, in the given
+// position, failing the test if any of them isn't legal.
+func applyUciMoves(t *testing.T, pos *Position, moves ...string) {
+	for _, uci := range moves {
+		mov, err := pos.ParseUciMove(uci)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		pos.ApplyMove(mov)
+	}
+}
@@ -0,0 +1,178 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// This file provides semantic validation of positions. A position can be
+// perfectly well-formed FEN and still be impossible to reach in a game of
+// chess; searching such a position can violate assumptions that the rest of
+// the engine makes (e.g. that there is a king to check).
+
+var PositionKingCountError = errors.New("each side must have exactly one king")
+var PositionPawnOnBackRankError = errors.New("pawns can't be on the first or eighth rank")
+var PositionOpponentInCheckError = errors.New("the side not to move is in check")
+var PositionCastleRightsError = errors.New("castling rights without king and rook on their home squares")
+var PositionEnPassantError = errors.New("en passant square not reachable by a double pawn push")
+
+// A ValidationError lists every reason that a position is not legal.
+type ValidationError struct {
+	Violations []error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		msgs = append(msgs, violation.Error())
+	}
+
+	return fmt.Sprintf("invalid position: %s", strings.Join(msgs, "; "))
+}
+
+// Is reports whether any of the individual violations is the target, so that
+// errors.Is can be used to test for any one of them.
+func (e *ValidationError) Is(target error) bool {
+	for _, violation := range e.Violations {
+		if errors.Is(violation, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unwrap returns the individual violations, for callers that want all of them
+// rather than one in particular.
+func (e *ValidationError) Unwrap() []error {
+	return e.Violations
+}
+
+// Validate checks that this position is one that could legally arise in a
+// game of chess. It returns nil if it is, or a *ValidationError listing every
+// rule that it breaks if it is not. The individual violations wrap the
+// Position*Error sentinels above.
+func (p *Position) Validate() error {
+	var violations []error
+	violate := func(sentinel error, format string, args ...interface{}) {
+		violations = append(violations, fmt.Errorf("%w: %s", sentinel, fmt.Sprintf(format, args...)))
+	}
+
+	for _, color := range [...]Color{White, Black} {
+		if kings := p.Kings(color).Count(); p.hasRoyalKing(color) && kings != 1 {
+			violate(PositionKingCountError, "%s has %d kings", color, kings)
+		}
+	}
+
+	backRanks := FullBitboard.Rank(Rank1) | FullBitboard.Rank(Rank8)
+	pawns := (p.Pawns(White) | p.Pawns(Black)) & backRanks
+	for _, color := range [...]Color{White, Black} {
+		if p.hasPawnsOnFirstRank(color) {
+			pawns &^= p.Pawns(color) & FullBitboard.Rank(backRank(color))
+		}
+	}
+
+	pawnIter := pawns.Iter()
+	for pawn, next := pawnIter.Next(); next; pawn, next = pawnIter.Next() {
+		violate(PositionPawnOnBackRankError, "pawn on %s", pawn)
+	}
+
+	if p.hasRoyalKing(p.sideToMove.Toggle()) && p.IsCheck(p.sideToMove.Toggle()) {
+		violate(PositionOpponentInCheckError, "%s is in check but it is %s's turn to move",
+			p.sideToMove.Toggle(), p.sideToMove)
+	}
+
+	for _, color := range [...]Color{White, Black} {
+		for _, kingside := range [...]bool{true, false} {
+			if err := p.castleRightsError(color, kingside); err != nil {
+				violations = append(violations, err)
+			}
+		}
+	}
+
+	if p.HasEnPassantSquare() {
+		if !p.enPassantSquareReachable() {
+			violate(PositionEnPassantError, "no %s pawn could have just double-pushed past %s",
+				p.sideToMove.Toggle(), p.enPassantSquare)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{violations}
+}
+
+// castleRightsError returns an error wrapping PositionCastleRightsError if
+// the given color has the right to castle to the given side without its king
+// and rook on squares that it could castle from, or nil otherwise.
+func (p *Position) castleRightsError(color Color, kingside bool) error {
+	if p.castleStatus&castleFlag(color, kingside) == 0 {
+		return nil
+	}
+
+	side := "kingside"
+	if !kingside {
+		side = "queenside"
+	}
+
+	// in standard chess the king and rooks have to be on their usual
+	// squares, while in Chess960 the king can be anywhere on the back rank
+	// as long as the rook is on the side of the king that it castles to.
+	rook := p.castleRooks[color][castleSide(kingside)]
+	king, hasKing := p.castleKing(color)
+	if !p.chess960 {
+		home := MakeSquare(backRank(color), FileE)
+		if !hasKing || king != home || !p.Rooks(color).Test(rook) {
+			return fmt.Errorf("%w: %s can castle %s but has no king on %s and rook on %s",
+				PositionCastleRightsError, color, side, home, rook)
+		}
+
+		return nil
+	}
+
+	if !hasKing || !p.Rooks(color).Test(rook) || (rook.File() > king.File()) != kingside {
+		return fmt.Errorf("%w: %s can castle %s but has no king on its back rank and rook on %s %s of it",
+			PositionCastleRightsError, color, side, rook, side)
+	}
+
+	return nil
+}
+
+// hasRoyalKing returns whether or not the given color has a king that it must
+// keep out of check in this position's variant, and so must have exactly one
+// of. In antichess, the king is an ordinary piece, and in Horde, White has no
+// king at all.
+func (p *Position) hasRoyalKing(color Color) bool {
+	return p.variant != Antichess && !(p.variant == Horde && color == White)
+}
+
+// enPassantSquareReachable returns whether or not the position's en passant
+// square is one that the previous move, a double pawn push by the side not to
+// move, could have produced.
+func (p *Position) enPassantSquareReachable() bool {
+	epSquare := p.enPassantSquare
+	pusher := p.sideToMove.Toggle()
+	var epRank Rank
+	var forward Direction
+	if pusher == White {
+		epRank = Rank3
+		forward = North
+	} else {
+		epRank = Rank6
+		forward = South
+	}
+
+	if epSquare.Rank() != epRank {
+		return false
+	}
+
+	// the pawn passed over the EP square from the square behind it and
+	// landed on the square in front of it.
+	origin := epSquare.Towards(forward.Opposite())
+	landing := epSquare.Towards(forward)
+	occupancy := p.White() | p.Black()
+	return p.Pawns(pusher).Test(landing) && !occupancy.Test(epSquare) && !occupancy.Test(origin)
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var validationTests = [...]struct {
+	name       string
+	fen        string
+	violations []error
+}{
+	{"starting-position", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", nil},
+	{"valid-en-passant", "rnbqkbnr/ppp1pppp/8/8/3pP3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 3", nil},
+	{"no-kings", "8/8/8/8/8/8/8/8 w - - 0 1", []error{PositionKingCountError, PositionKingCountError}},
+	{"three-kings", "k7/8/8/8/8/8/8/K1K5 w - - 0 1", []error{PositionKingCountError}},
+	{"pawn-on-back-rank", "k6P/8/8/8/8/8/8/K2p4 w - - 0 1", []error{PositionPawnOnBackRankError, PositionPawnOnBackRankError}},
+	{"opponent-in-check", "k7/8/8/8/8/8/8/K6r b - - 0 1", []error{PositionOpponentInCheckError}},
+	{"castle-without-rook", "4k3/8/8/8/8/8/8/4K3 w K - 0 1", []error{PositionCastleRightsError}},
+	{"castle-moved-king", "r3k2r/8/8/8/8/8/8/R2K3R w Qkq - 0 1", []error{PositionCastleRightsError}},
+	{"chess960-castle", "r2k3r/8/8/8/8/8/8/R2K3R w HAha - 0 1", nil},
+	{"en-passant-wrong-rank", "4k3/8/8/8/4P3/8/8/4K3 w - e3 0 1", []error{PositionEnPassantError}},
+	{"en-passant-no-pawn", "4k3/8/8/8/8/8/8/4K3 b - e3 0 1", []error{PositionEnPassantError}},
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	for _, test := range validationTests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			err = pos.Validate()
+			if test.violations == nil {
+				assert.NoError(tt, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			if !assert.True(tt, errors.As(err, &validationErr)) {
+				tt.FailNow()
+			}
+
+			if !assert.Len(tt, validationErr.Violations, len(test.violations), "%s", err) {
+				tt.FailNow()
+			}
+
+			for i, violation := range validationErr.Violations {
+				assert.True(tt, errors.Is(violation, test.violations[i]), "%s", violation)
+				assert.True(tt, validationErr.Is(test.violations[i]), "%s", err)
+			}
+		})
+	}
+}
+
+func TestFenValidateOption(t *testing.T) {
+	t.Parallel()
+	_, err := MakePositionFromFen("8/8/8/8/8/8/8/8 w - - 0 1", FenValidate())
+	assert.True(t, errors.Is(err, PositionKingCountError))
+
+	pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/4K3 w - - 0 1", FenValidate())
+	assert.NoError(t, err)
+	assert.NotNil(t, pos)
+}
+
+func TestValidationErrorIs(t *testing.T) {
+	t.Parallel()
+	err := &ValidationError{[]error{
+		fmt.Errorf("%w: white has 0 kings", PositionKingCountError),
+		fmt.Errorf("%w: no black pawn could have just double-pushed past e6", PositionEnPassantError),
+	}}
+
+	assert.True(t, err.Is(PositionKingCountError))
+	assert.True(t, err.Is(PositionEnPassantError))
+	assert.False(t, err.Is(PositionCastleRightsError))
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+)
+
+// This file defines the Variant interface, which lets the rules of the game
+// differ from standard chess, and the rules of standard chess itself.
+
+var UnknownVariantError = errors.New("unknown chess variant")
+
+// A Variant is a set of rules for playing chess. Every Position plays by the
+// rules of some variant, which decide which moves are legal and when the game
+// is over. Standard chess is itself a Variant.
+type Variant interface {
+	// Name returns the name of this variant, as used by the UCI_Variant
+	// option.
+	Name() string
+
+	// PseudolegalMoves adds the moves available to the side to move that
+	// follow the movement rules of the pieces in this variant to the list.
+	PseudolegalMoves(pos *Position, list *MoveList)
+
+	// LegalMoves filters the given list of pseudo-legal moves down to the
+	// moves that are legal in this variant. Moves are tried out on scratch,
+	// which is overwritten, so that filtering them doesn't allocate.
+	LegalMoves(pos *Position, list *MoveList, scratch *Position)
+
+	// VariantOutcome returns the result of the game if it has ended by a
+	// rule specific to this variant, such as a king reaching the center in
+	// king of the hill. It is checked before any moves are generated; a
+	// game that has ended this way has no legal moves.
+	VariantOutcome(pos *Position) (Result, bool)
+
+	// NoMovesOutcome returns the result of the game when the side to move
+	// has no legal moves.
+	NoMovesOutcome(pos *Position) Result
+
+	// AfterMove is called at the end of ApplyMove, so that the variant can
+	// update any state of its own.
+	AfterMove(pos *Position, mov Move)
+}
+
+// A FenExtension is a Variant whose positions have state that is written in
+// FEN as an extra field after the en-passant square.
+type FenExtension interface {
+	Variant
+
+	// ParseFenField parses the extra FEN field into the given position.
+	ParseFenField(pos *Position, field string) error
+
+	// FenField returns the extra FEN field for the given position.
+	FenField(pos *Position) string
+}
+
+// A Result is the result of a game that has ended.
+type Result int
+
+const (
+	WhiteWins Result = iota
+	BlackWins
+	Draw
+)
+
+// WinFor returns the Result of a game won by the given color.
+func WinFor(color Color) Result {
+	if color == White {
+		return WhiteWins
+	}
+
+	return BlackWins
+}
+
+func (r Result) String() string {
+	switch r {
+	case WhiteWins:
+		return "1-0"
+	case BlackWins:
+		return "0-1"
+	case Draw:
+		return "1/2-1/2"
+	}
+
+	panic("unknown Result")
+}
+
+// Standard is the Variant for standard chess.
+var Standard Variant = standard{}
+
+type standard struct{}
+
+func (standard) Name() string {
+	return "chess"
+}
+
+func (standard) PseudolegalMoves(pos *Position, list *MoveList) {
+	generatePseudolegalMoves(pos, list)
+}
+
+// LegalMoves filters out the pseudo-legal moves that leave the mover in
+// check.
+func (standard) LegalMoves(pos *Position, list *MoveList, scratch *Position) {
+	toMove := pos.SideToMove()
+	list.Filter(func(mov Move) bool {
+		*scratch = *pos
+		scratch.ApplyMove(mov)
+		return !scratch.IsCheck(toMove)
+	})
+}
+
+func (standard) VariantOutcome(pos *Position) (Result, bool) {
+	return Draw, false
+}
+
+// NoMovesOutcome returns a win for the other side if the side to move is
+// checkmated, or a draw if it is stalemated.
+func (standard) NoMovesOutcome(pos *Position) Result {
+	if pos.IsCheck(pos.SideToMove()) {
+		return WinFor(pos.SideToMove().Toggle())
+	}
+
+	return Draw
+}
+
+func (standard) AfterMove(pos *Position, mov Move) {}
+
+// variants is every Variant that LookupVariant knows about, by name.
+var variants = map[string]Variant{
+	Standard.Name():      Standard,
+	Antichess.Name():     Antichess,
+	ThreeCheck.Name():    ThreeCheck,
+	KingOfTheHill.Name(): KingOfTheHill,
+	Crazyhouse.Name():    Crazyhouse,
+	Atomic.Name():        Atomic,
+	RacingKings.Name():   RacingKings,
+	Horde.Name():         Horde,
+}
+
+// LookupVariant returns the Variant with the given name.
+func LookupVariant(name string) (Variant, error) {
+	if variant, ok := variants[name]; ok {
+		return variant, nil
+	}
+
+	return nil, fmt.Errorf("%w: `%s`", UnknownVariantError, name)
+}
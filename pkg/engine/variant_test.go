@@ -0,0 +1,318 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var outcomeTests = [...]struct {
+	name    string
+	variant Variant
+	fen     string
+	result  Result
+	over    bool
+}{
+	{"standard-in-progress", Standard, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", Draw, false},
+	{"standard-checkmate", Standard, "rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", BlackWins, true},
+	{"standard-stalemate", Standard, "7k/5Q2/6K1/8/8/8/8/8 b - - 0 1", Draw, true},
+	{"antichess-no-pieces", Antichess, "8/8/8/8/8/8/8/4K3 b - - 0 1", BlackWins, true},
+	{"antichess-stalemate", Antichess, "8/8/8/8/8/p7/P7/8 w - - 0 1", WhiteWins, true},
+	{"antichess-in-progress", Antichess, "8/8/8/8/8/p7/P7/7K w - - 0 1", Draw, false},
+	{"three-check-third-check", ThreeCheck, "4k3/8/8/8/8/8/8/4K2R b K - 3+0 0 1", BlackWins, true},
+	{"three-check-in-progress", ThreeCheck, "4k3/8/8/8/8/8/8/4K2R b K - 1+1 0 1", Draw, false},
+	{"king-of-the-hill-center", KingOfTheHill, "4k3/8/8/8/3K4/8/8/8 b - - 0 1", WhiteWins, true},
+	{"king-of-the-hill-in-progress", KingOfTheHill, "4k3/8/8/8/8/3K4/8/8 b - - 0 1", Draw, false},
+	{"atomic-king-exploded", Atomic, "8/8/8/8/8/8/8/4K3 b - - 0 1", WhiteWins, true},
+	{"atomic-escape-by-touching-kings", Atomic, "3k4/3Q4/3K4/8/8/8/8/8 b - - 0 1", Draw, false},
+	{"racing-kings-black-wins", RacingKings, "1k6/8/8/8/8/8/8/7K w - - 0 1", BlackWins, true},
+	{"racing-kings-white-wins", RacingKings, "1K6/8/8/8/8/8/7k/8 b - - 0 1", WhiteWins, true},
+	{"racing-kings-black-can-draw", RacingKings, "1K6/7k/8/8/8/8/8/8 b - - 0 1", Draw, false},
+	{"racing-kings-both-on-goal", RacingKings, "1K5k/8/8/8/8/8/8/8 w - - 0 1", Draw, true},
+	{"horde-all-captured", Horde, "4k3/8/8/8/8/8/8/8 w - - 0 1", BlackWins, true},
+	{"horde-checkmate", Horde, "k7/1PP5/PP6/8/8/8/8/8 b - - 0 1", WhiteWins, true},
+	{"horde-white-stalemated", Horde, "4k3/8/8/8/8/4p3/4P3/8 w - - 0 1", Draw, true},
+}
+
+func TestOutcome(t *testing.T) {
+	t.Parallel()
+	for _, test := range outcomeTests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			pos, err := MakePositionFromFen(test.fen, FenVariant(test.variant))
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			result, over := pos.Outcome()
+			assert.Equal(tt, test.over, over)
+			if test.over {
+				assert.Equal(tt, test.result, result)
+				assert.Empty(tt, pos.LegalMoves())
+			}
+		})
+	}
+}
+
+func TestAntichessForcedCapture(t *testing.T) {
+	t.Parallel()
+	pos, err := MakePositionFromFen("4k3/8/8/3p4/4P3/8/8/R3K2R w KQ - 0 1", FenVariant(Antichess))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, []Move{MakeCaptureMove(E4, D5)}, pos.LegalMoves())
+
+	// without a capture to make, the king can walk into check but can't
+	// castle.
+	pos, err = MakePositionFromFen("3rk3/8/8/8/8/8/8/R3K2R w KQ - 0 1", FenVariant(Antichess))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	moves := pos.LegalMoves()
+	assert.Contains(t, moves, MakeQuietMove(E1, D1))
+	for _, mov := range moves {
+		assert.False(t, mov.IsKingsideCastle() || mov.IsQueensideCastle(), "%s", mov)
+	}
+}
+
+func TestThreeCheck(t *testing.T) {
+	t.Parallel()
+	t.Run("fen-round-trip", func(tt *testing.T) {
+		fen := "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+2 0 1"
+		pos, err := MakePositionFromFen(fen, FenVariant(ThreeCheck))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, 0, pos.ChecksGiven(White))
+		assert.Equal(tt, 1, pos.ChecksGiven(Black))
+		assert.Equal(tt, fen, pos.AsFen())
+	})
+
+	t.Run("counts-checks", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/4K2R w - - 3+3 0 1", FenVariant(ThreeCheck))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		applyUciMoves(tt, pos, "h1h8")
+		assert.Equal(tt, 1, pos.ChecksGiven(White))
+		assert.Equal(tt, "4k2R/8/8/8/8/8/8/4K3 b - - 2+3 1 1", pos.AsFen())
+	})
+
+	t.Run("bad-field", func(tt *testing.T) {
+		for _, fen := range []string{
+			"4k3/8/8/8/8/8/8/4K3 w - - 4+3 0 1",
+			"4k3/8/8/8/8/8/8/4K3 w - - 3 0 1",
+			"4k3/8/8/8/8/8/8/4K3 w - - 0 1",
+		} {
+			_, err := MakePositionFromFen(fen, FenVariant(ThreeCheck))
+			var fenErr *FenError
+			if assert.True(tt, errors.As(err, &fenErr), "%s", fen) {
+				assert.Equal(tt, FenFieldVariant, fenErr.Field)
+			}
+		}
+	})
+}
+
+func TestLookupVariant(t *testing.T) {
+	t.Parallel()
+	for _, variant := range []Variant{Standard, Antichess, ThreeCheck, KingOfTheHill, Crazyhouse, Atomic, RacingKings, Horde} {
+		found, err := LookupVariant(variant.Name())
+		if assert.NoError(t, err) {
+			assert.Equal(t, variant, found)
+		}
+	}
+
+	_, err := LookupVariant("bughouse")
+	assert.True(t, errors.Is(err, UnknownVariantError))
+}
+
+func TestCrazyhouse(t *testing.T) {
+	t.Parallel()
+	t.Run("fen-round-trip", func(tt *testing.T) {
+		fen := "r1bqk2r/pppp1ppp/2n5/4P3/1b1Pn3/2NB1N2/PPP2PPP/R1BQ~K2R[BNPnp] b KQkq - 0 1"
+		pos, err := MakePositionFromFen(fen, FenVariant(Crazyhouse))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, 1, pos.Pocket(White, Knight))
+		assert.Equal(tt, 1, pos.Pocket(White, Bishop))
+		assert.Equal(tt, 1, pos.Pocket(White, Pawn))
+		assert.Equal(tt, 1, pos.Pocket(Black, Knight))
+		assert.Equal(tt, 1, pos.Pocket(Black, Pawn))
+		assert.Equal(tt, Bitboard(1<<D1), pos.Promoted())
+		assert.Equal(tt, fen, pos.AsFen())
+	})
+
+	t.Run("ninth-rank-pocket", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/4K3/Qp w - - 0 1", FenVariant(Crazyhouse))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "4k3/8/8/8/8/8/8/4K3[Qp] w - - 0 1", pos.AsFen())
+	})
+
+	t.Run("bad-pocket", func(tt *testing.T) {
+		for _, fen := range []string{
+			"4k3/8/8/8/8/8/8/4K3 w - - 0 1",
+			"4k3/8/8/8/8/8/8/4K3[K] w - - 0 1",
+			"4k3/8/8/8/8/8/8/4K3[Q w - - 0 1",
+		} {
+			_, err := MakePositionFromFen(fen, FenVariant(Crazyhouse))
+			assert.True(tt, errors.Is(err, FenInvalidPocketError), "%s", fen)
+		}
+	})
+
+	t.Run("captures-go-to-pocket", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/3q~r3/4P3/8/8/4K3[] w - - 0 1", FenVariant(Crazyhouse))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		// the queen was promoted, so it goes into the pocket as a pawn.
+		promoted := pos.Clone()
+		promoted.ApplyMove(MakeCaptureMove(E4, D5))
+		assert.Equal(tt, 1, promoted.Pocket(White, Pawn))
+		assert.Equal(tt, 0, promoted.Pocket(White, Queen))
+		assert.Equal(tt, EmptyBitboard, promoted.Promoted())
+
+		pos.ApplyMove(MakeCaptureMove(E4, E5))
+		assert.Equal(tt, 1, pos.Pocket(White, Rook))
+		assert.Equal(tt, Bitboard(1<<D5), pos.Promoted())
+	})
+
+	t.Run("drops", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/8/8/4K3[Pn] w - - 0 1", FenVariant(Crazyhouse))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		moves := pos.LegalMoves()
+		assert.Contains(tt, moves, MakeDropMove(Pawn, E4))
+		assert.NotContains(tt, moves, MakeDropMove(Pawn, A8))
+		assert.NotContains(tt, moves, MakeDropMove(Pawn, A1))
+		assert.NotContains(tt, moves, MakeDropMove(Knight, F3))
+		assert.NotContains(tt, moves, MakeDropMove(Pawn, E1))
+
+		mov, err := pos.ParseSanMove("@e4")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, MakeDropMove(Pawn, E4), mov)
+		assert.Equal(tt, "P@e4", pos.SanString(mov))
+
+		pos.ApplyMove(mov)
+		assert.Equal(tt, 0, pos.Pocket(White, Pawn))
+		mov, err = pos.ParseSanMove("N@d3+")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "N@d3+", pos.SanString(mov))
+		// drops don't reset the halfmove clock, even pawn drops.
+		pos.ApplyMove(mov)
+		assert.Equal(tt, "4k3/8/8/8/4P3/3n4/8/4K3[] w - - 2 2", pos.AsFen())
+	})
+}
+
+func TestAtomic(t *testing.T) {
+	t.Parallel()
+	t.Run("explosion", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("r3k2r/8/8/8/8/8/6pp/R3K1NR b KQkq - 0 1", FenVariant(Atomic))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		// the knight, the capturing pawn and the rook next to it explode,
+		// while the pawn on h2 survives.
+		applyUciMoves(tt, pos, "h2g1q")
+		assert.Equal(tt, "r3k2r/8/8/8/8/8/6p1/R3K3 w Qkq - 0 2", pos.AsFen())
+	})
+
+	t.Run("kings-cannot-capture", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", FenVariant(Atomic))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.NotContains(tt, pos.LegalMoves(), MakeCaptureMove(E1, E2))
+	})
+
+	t.Run("touching-kings", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("8/8/8/8/3k4/3K4/8/7q w - - 0 1", FenVariant(Atomic))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		// the queen can't capture a king that touches its own.
+		assert.False(tt, pos.IsCheck(White))
+		assert.Contains(tt, pos.LegalMoves(), MakeQuietMove(D3, C4))
+	})
+
+	t.Run("exploding-the-king-wins", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/4p3/8/8/8/8/8/4QK2 w - - 0 1", FenVariant(Atomic))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		applyUciMoves(tt, pos, "e1e7")
+		result, over := pos.Outcome()
+		assert.True(tt, over)
+		assert.Equal(tt, WhiteWins, result)
+	})
+}
+
+func TestRacingKings(t *testing.T) {
+	t.Parallel()
+	pos, err := MakePositionFromFen("8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1", FenVariant(RacingKings))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// Ne2-c3 would check the black king and so isn't legal, even though
+	// it doesn't leave White in check.
+	moves := pos.LegalMoves()
+	assert.Len(t, moves, 21)
+	assert.NotContains(t, moves, MakeQuietMove(E2, C3))
+	for _, mov := range moves {
+		newPos := pos.Clone()
+		newPos.ApplyMove(mov)
+		assert.False(t, newPos.IsCheck(Black), "%s", mov)
+	}
+}
+
+func TestHorde(t *testing.T) {
+	t.Parallel()
+	const start = "rnbqkbnr/pppppppp/8/1PP2PP1/PPPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 1"
+	t.Run("validate", func(tt *testing.T) {
+		_, err := MakePositionFromFen(start, FenVariant(Horde), FenValidate())
+		assert.NoError(tt, err)
+
+		_, err = MakePositionFromFen(start, FenValidate())
+		assert.True(tt, errors.Is(err, PositionKingCountError))
+		assert.True(tt, errors.Is(err, PositionPawnOnBackRankError))
+	})
+
+	t.Run("first-rank-pushes", func(tt *testing.T) {
+		pos, err := MakePositionFromFen("4k3/8/8/8/3p4/8/8/4P3 w - - 0 1", FenVariant(Horde))
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		moves := pos.LegalMoves()
+		assert.Contains(tt, moves, MakeQuietMove(E1, E2))
+		assert.Contains(tt, moves, MakeQuietMove(E1, E3))
+
+		// the pawn on d4 can't capture the pawn on e3 en passant.
+		applyUciMoves(tt, pos, "e1e3")
+		assert.False(tt, pos.HasEnPassantSquare())
+		assert.NotContains(tt, pos.LegalMoves(), MakeEnPassantMove(D4, E2))
+	})
+}
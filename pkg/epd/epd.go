@@ -0,0 +1,461 @@
+// Package epd reads and writes Extended Position Description (EPD) records,
+// the format used by most test suites (WAC, STS, Bratko-Kopec) and perft
+// suites.
+//
+// An EPD record is the first four fields of a FEN string (the board, side to
+// move, castling rights and en-passant square) followed by any number of
+// semicolon-terminated operations. Each operation is an opcode followed by
+// zero or more operands, e.g.
+//
+//	r1b1k2r/1pp1q2p/p1n3p1/3QPp2/8/1BP3B1/P5PP/3R1RK1 w kq - bm Rd4; id "WAC.099";
+//
+// The halfmove and fullmove clocks, if present, are stored in the hmvc and
+// fmvn opcodes. Records that are full FEN strings, with the clocks as the
+// fifth and sixth fields, are accepted too.
+package epd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+var EpdTooFewFieldsError = errors.New("EPD record has fewer than four position fields")
+var EpdUnterminatedStringError = errors.New("unterminated string operand in EPD record")
+var EpdInvalidOperandError = errors.New("invalid operand in EPD record")
+
+// A Record is a single parsed EPD record.
+type Record struct {
+	// The position described by the record. Its clocks are taken from
+	// the hmvc and fmvn opcodes, or else from the FEN clock fields, and
+	// default to 0 and 1.
+	Position *engine.Position
+
+	// The "id" opcode: the name of this record within its suite.
+	ID string
+
+	// The "bm" opcode: the best moves in this position.
+	BestMoves []engine.Move
+
+	// The "am" opcode: the moves to avoid in this position.
+	AvoidMoves []engine.Move
+
+	// The "pv" opcode: the predicted principal variation, beginning
+	// from this position.
+	PrincipalVariation []engine.Move
+
+	// The "c0" through "c9" opcodes: free-form comments.
+	Comments [10]string
+
+	// The "acd" opcode: the depth of the analysis that produced this
+	// record, or -1 if not present.
+	AnalysisDepth int
+
+	// The "ce" opcode: the centipawn evaluation of this position from the
+	// perspective of the side to move, if present.
+	CentipawnEval *int
+
+	// The "D1" through "Dn" opcodes used by perft suites, mapping a depth
+	// to the number of leaf nodes at that depth.
+	PerftNodes map[int]uint64
+
+	// Any opcodes not listed above, with their operands, in the order they
+	// appeared.
+	Other []Operation
+}
+
+// An Operation is an opcode and its operands. String operands are stored
+// without their surrounding quotes.
+type Operation struct {
+	Opcode   string
+	Operands []string
+}
+
+// Parse parses a single EPD record.
+func Parse(line string) (*Record, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("%w: `%s`", EpdTooFewFieldsError, line)
+	}
+
+	// a record copied from a full FEN string has the clocks after the first
+	// four fields. no opcode is a number, so two numbers there can only be
+	// the clocks.
+	halfmove, fullmove := "0", "1"
+	positionFields := 4
+	if len(fields) >= 6 && isClock(fields[4]) && isClock(fields[5]) {
+		halfmove, fullmove = fields[4], fields[5]
+		positionFields = 6
+	}
+
+	// the operations are everything after the position fields, which we
+	// find by skipping over them in the original string so as to preserve
+	// the spacing inside of quoted operands.
+	rest := strings.TrimLeft(line, " \t")
+	for i := 0; i < positionFields; i++ {
+		rest = strings.TrimLeft(rest[len(fields[i]):], " \t")
+	}
+
+	ops, err := parseOperations(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	// the hmvc and fmvn opcodes win over the FEN clock fields.
+	for _, op := range ops {
+		switch op.Opcode {
+		case "hmvc":
+			if len(op.Operands) != 1 {
+				return nil, operandError(op)
+			}
+
+			halfmove = op.Operands[0]
+		case "fmvn":
+			if len(op.Operands) != 1 {
+				return nil, operandError(op)
+			}
+
+			fullmove = op.Operands[0]
+		}
+	}
+
+	fen := strings.Join(append(fields[:4:4], halfmove, fullmove), " ")
+	pos, err := engine.MakePositionFromFen(fen)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &Record{Position: pos, AnalysisDepth: -1}
+	for _, op := range ops {
+		if err := record.apply(op); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
+
+// isClock returns whether or not the given field is a FEN clock, a
+// non-negative integer.
+func isClock(field string) bool {
+	value, err := strconv.Atoi(field)
+	return err == nil && value >= 0
+}
+
+func operandError(op Operation) error {
+	return fmt.Errorf("%w: %s %s", EpdInvalidOperandError, op.Opcode, strings.Join(op.Operands, " "))
+}
+
+// apply interprets a single operation and stores its result in the record.
+func (r *Record) apply(op Operation) error {
+	single := func() (string, error) {
+		if len(op.Operands) != 1 {
+			return "", operandError(op)
+		}
+
+		return op.Operands[0], nil
+	}
+
+	integer := func() (int, error) {
+		operand, err := single()
+		if err != nil {
+			return 0, err
+		}
+
+		value, err := strconv.Atoi(operand)
+		if err != nil {
+			return 0, operandError(op)
+		}
+
+		return value, nil
+	}
+
+	sanMoves := func() ([]engine.Move, error) {
+		var moves []engine.Move
+		for _, san := range op.Operands {
+			mov, err := r.Position.ParseSanMove(san)
+			if err != nil {
+				return nil, err
+			}
+
+			moves = append(moves, mov)
+		}
+
+		return moves, nil
+	}
+
+	var err error
+	switch op.Opcode {
+	case "hmvc", "fmvn":
+		// already handled when the position was parsed.
+	case "id":
+		r.ID, err = single()
+	case "bm":
+		r.BestMoves, err = sanMoves()
+	case "am":
+		r.AvoidMoves, err = sanMoves()
+	case "pv":
+		// each move in the principal variation is played from the
+		// position left by the previous one.
+		pos := r.Position.Clone()
+		for _, san := range op.Operands {
+			mov, err := pos.ParseSanMove(san)
+			if err != nil {
+				return err
+			}
+
+			r.PrincipalVariation = append(r.PrincipalVariation, mov)
+			pos.ApplyMove(mov)
+		}
+	case "acd":
+		r.AnalysisDepth, err = integer()
+	case "ce":
+		var eval int
+		if eval, err = integer(); err == nil {
+			r.CentipawnEval = &eval
+		}
+	default:
+		if index, ok := commentIndex(op.Opcode); ok {
+			r.Comments[index], err = single()
+		} else if depth, ok := perftDepth(op.Opcode); ok {
+			var nodes int
+			if nodes, err = integer(); err == nil {
+				if nodes < 0 {
+					return operandError(op)
+				}
+
+				if r.PerftNodes == nil {
+					r.PerftNodes = make(map[int]uint64)
+				}
+
+				r.PerftNodes[depth] = uint64(nodes)
+			}
+		} else {
+			r.Other = append(r.Other, op)
+		}
+	}
+
+	return err
+}
+
+// commentIndex returns the index of a "c0" through "c9" opcode.
+func commentIndex(opcode string) (int, bool) {
+	if len(opcode) == 2 && opcode[0] == 'c' && opcode[1] >= '0' && opcode[1] <= '9' {
+		return int(opcode[1] - '0'), true
+	}
+
+	return 0, false
+}
+
+// perftDepth returns the depth of a "D1" through "Dn" opcode.
+func perftDepth(opcode string) (int, bool) {
+	if len(opcode) < 2 || opcode[0] != 'D' {
+		return 0, false
+	}
+
+	depth, err := strconv.Atoi(opcode[1:])
+	if err != nil || depth < 1 {
+		return 0, false
+	}
+
+	return depth, true
+}
+
+// parseOperations splits the operation section of a record into operations.
+// Operations are terminated by semicolons, although the final semicolon may
+// be omitted. Perft suites conventionally put the semicolon before each
+// operation rather than after it, which this also accepts.
+func parseOperations(text string) ([]Operation, error) {
+	var ops []Operation
+	var tokens []string
+	finish := func() {
+		if len(tokens) != 0 {
+			ops = append(ops, Operation{tokens[0], tokens[1:]})
+		}
+
+		tokens = nil
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == ';':
+			finish()
+			i++
+		case r == ' ' || r == '\t':
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("%w: `%s`", EpdUnterminatedStringError, text)
+			}
+
+			tokens = append(tokens, string(runes[i+1:end]))
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && runes[end] != ' ' && runes[end] != '\t' && runes[end] != ';' {
+				end++
+			}
+
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		}
+	}
+
+	finish()
+	return ops, nil
+}
+
+// String returns the EPD representation of this record. Opcodes are written
+// in a fixed order: id, bm, am, pv, acd, ce, the perft depths, the comments,
+// the clocks (if they aren't the defaults) and then any others.
+func (r *Record) String() string {
+	fen := strings.Fields(r.Position.AsCanonicalFen())
+	ops := []string{strings.Join(fen[:4], " ")}
+	add := func(opcode string, operands ...string) {
+		ops = append(ops, strings.Join(append([]string{opcode}, operands...), " ")+";")
+	}
+
+	quote := func(operand string) string {
+		return fmt.Sprintf("\"%s\"", operand)
+	}
+
+	sans := func(pos *engine.Position, moves []engine.Move) []string {
+		var strs []string
+		for _, mov := range moves {
+			strs = append(strs, pos.SanString(mov))
+		}
+
+		return strs
+	}
+
+	if r.ID != "" {
+		add("id", quote(r.ID))
+	}
+
+	if len(r.BestMoves) != 0 {
+		add("bm", sans(r.Position, r.BestMoves)...)
+	}
+
+	if len(r.AvoidMoves) != 0 {
+		add("am", sans(r.Position, r.AvoidMoves)...)
+	}
+
+	if len(r.PrincipalVariation) != 0 {
+		var strs []string
+		pos := r.Position.Clone()
+		for _, mov := range r.PrincipalVariation {
+			strs = append(strs, pos.SanString(mov))
+			pos.ApplyMove(mov)
+		}
+
+		add("pv", strs...)
+	}
+
+	if r.AnalysisDepth >= 0 {
+		add("acd", strconv.Itoa(r.AnalysisDepth))
+	}
+
+	if r.CentipawnEval != nil {
+		add("ce", strconv.Itoa(*r.CentipawnEval))
+	}
+
+	depths := make([]int, 0, len(r.PerftNodes))
+	for depth := range r.PerftNodes {
+		depths = append(depths, depth)
+	}
+
+	sort.Ints(depths)
+	for _, depth := range depths {
+		add(fmt.Sprintf("D%d", depth), strconv.FormatUint(r.PerftNodes[depth], 10))
+	}
+
+	for i, comment := range r.Comments {
+		if comment != "" {
+			add(fmt.Sprintf("c%d", i), quote(comment))
+		}
+	}
+
+	if halfmove := r.Position.HalfmoveClock(); halfmove != 0 {
+		add("hmvc", strconv.FormatUint(uint64(halfmove), 10))
+	}
+
+	if fullmove := r.Position.FullmoveClock(); fullmove != 1 {
+		add("fmvn", strconv.FormatUint(uint64(fullmove), 10))
+	}
+
+	for _, op := range r.Other {
+		operands := make([]string, 0, len(op.Operands))
+		for _, operand := range op.Operands {
+			if strings.ContainsAny(operand, " \t;") || operand == "" {
+				operand = quote(operand)
+			}
+
+			operands = append(operands, operand)
+		}
+
+		add(op.Opcode, operands...)
+	}
+
+	return strings.Join(ops, " ")
+}
+
+// A Reader reads EPD records from a stream, one per line. Blank lines and
+// lines beginning with "#" are skipped.
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewReader creates a new Reader that reads from the given io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{scanner: bufio.NewScanner(r)}
+}
+
+// Read reads the next record from the stream. It returns io.EOF when there
+// are no records remaining. Parse errors are annotated with the line number
+// they occurred on.
+func (r *Reader) Read() (*Record, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		record, err := Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+
+		return record, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// Write writes the given records to the given io.Writer, one per line.
+func Write(w io.Writer, records ...*Record) error {
+	for _, record := range records {
+		if _, err := fmt.Fprintln(w, record.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package epd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	t.Run("test-suite", func(tt *testing.T) {
+		record, err := Parse(`2rr3k/pp3pp1/1nnqbN1p/3pN3/2pP4/2P3Q1/PPB4P/R4RK1 w - - bm Qg6; id "WAC.001"; c0 "mate in 3"; acd 12; ce 32767;`)
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "WAC.001", record.ID)
+		assert.Equal(tt, []engine.Move{engine.MakeQuietMove(engine.G3, engine.G6)}, record.BestMoves)
+		assert.Equal(tt, "mate in 3", record.Comments[0])
+		assert.Equal(tt, 12, record.AnalysisDepth)
+		if assert.NotNil(tt, record.CentipawnEval) {
+			assert.Equal(tt, 32767, *record.CentipawnEval)
+		}
+
+		assert.Equal(tt, "2rr3k/pp3pp1/1nnqbN1p/3pN3/2pP4/2P3Q1/PPB4P/R4RK1 w - - 0 1", record.Position.AsFen())
+	})
+
+	t.Run("multiple-best-and-avoid", func(tt *testing.T) {
+		record, err := Parse(`r1bqkbnr/pppp1ppp/2n5/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq - bm Bb5 Bc4; am Qe2;`)
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, []engine.Move{
+			engine.MakeQuietMove(engine.F1, engine.B5),
+			engine.MakeQuietMove(engine.F1, engine.C4),
+		}, record.BestMoves)
+		assert.Equal(tt, []engine.Move{engine.MakeQuietMove(engine.D1, engine.E2)}, record.AvoidMoves)
+	})
+
+	t.Run("perft-suite", func(tt *testing.T) {
+		record, err := Parse("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - ;D1 20 ;D2 400 ;D3 8902")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, map[int]uint64{1: 20, 2: 400, 3: 8902}, record.PerftNodes)
+	})
+
+	t.Run("principal-variation", func(tt *testing.T) {
+		record, err := Parse("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - pv e4 e5 Nf3;")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, []engine.Move{
+			engine.MakeDoublePawnPushMove(engine.E2, engine.E4),
+			engine.MakeDoublePawnPushMove(engine.E7, engine.E5),
+			engine.MakeQuietMove(engine.G1, engine.F3),
+		}, record.PrincipalVariation)
+	})
+
+	t.Run("clocks-and-unknown-opcodes", func(tt *testing.T) {
+		record, err := Parse(`8/8/8/8/8/8/8/K1k5 b - - hmvc 7; fmvn 42; sm Kc2; c9 "spaced  out";`)
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, uint32(7), record.Position.HalfmoveClock())
+		assert.Equal(tt, uint32(42), record.Position.FullmoveClock())
+		assert.Equal(tt, []Operation{{"sm", []string{"Kc2"}}}, record.Other)
+		assert.Equal(tt, "spaced  out", record.Comments[9])
+	})
+
+	t.Run("fen-clocks", func(tt *testing.T) {
+		record, err := Parse("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3 17")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3 17", record.Position.AsFen())
+		assert.Empty(tt, record.Other)
+	})
+
+	t.Run("fen-clocks-and-opcodes", func(tt *testing.T) {
+		record, err := Parse(`rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 bm e4; id "x";`)
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, "x", record.ID)
+		assert.Equal(tt, []engine.Move{engine.MakeDoublePawnPushMove(engine.E2, engine.E4)}, record.BestMoves)
+		assert.Empty(tt, record.Other)
+	})
+
+	t.Run("fen-clocks-perft-suite", func(tt *testing.T) {
+		record, err := Parse("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 ;D1 20 ;D2 400")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, map[int]uint64{1: 20, 2: 400}, record.PerftNodes)
+		assert.Empty(tt, record.Other)
+	})
+
+	t.Run("opcodes-override-fen-clocks", func(tt *testing.T) {
+		record, err := Parse("8/8/8/8/8/8/8/K1k5 b - - 5 30 hmvc 7; fmvn 42;")
+		if !assert.NoError(tt, err) {
+			tt.FailNow()
+		}
+
+		assert.Equal(tt, uint32(7), record.Position.HalfmoveClock())
+		assert.Equal(tt, uint32(42), record.Position.FullmoveClock())
+	})
+
+	t.Run("errors", func(tt *testing.T) {
+		_, err := Parse("8/8/8/8 w")
+		assert.True(tt, errors.Is(err, EpdTooFewFieldsError))
+
+		_, err = Parse(`4k3/8/8/8/8/8/8/4K3 w - - id "unterminated;`)
+		assert.True(tt, errors.Is(err, EpdUnterminatedStringError))
+
+		_, err = Parse(`4k3/8/8/8/8/8/8/4K3 w - - acd deep;`)
+		assert.True(tt, errors.Is(err, EpdInvalidOperandError))
+
+		_, err = Parse(`4k3/8/8/8/8/8/8/4K3 w - - bm Qh5;`)
+		assert.True(tt, errors.Is(err, engine.SanNoSuchMoveError))
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	lines := []string{
+		`2rr3k/pp3pp1/1nnqbN1p/3pN3/2pP4/2P3Q1/PPB4P/R4RK1 w - - id "WAC.001"; bm Qg6; acd 12; ce 32767; c0 "mate in 3";`,
+		`rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - D1 20; D2 400; D3 8902;`,
+		`8/8/8/8/8/8/8/K1k5 b - - hmvc 7; fmvn 42; sm Kc2;`,
+	}
+
+	buf := new(bytes.Buffer)
+	reader := NewReader(strings.NewReader("# a comment\n\n" + strings.Join(lines, "\n")))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		assert.NoError(t, Write(buf, record))
+	}
+
+	assert.Equal(t, strings.Join(lines, "\n")+"\n", buf.String())
+}
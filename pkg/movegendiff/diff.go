@@ -0,0 +1,180 @@
+package movegendiff
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// the number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+type edit struct {
+	kind editKind
+	line string
+}
+
+// UnifiedDiff produces a unified diff that transforms the lines a into the
+// lines b, or the empty string if they are identical. The inputs are small
+// (no position has more than a couple hundred legal moves), so the diff is
+// computed with a straightforward longest-common-subsequence table.
+func UnifiedDiff(fromName, toName string, a, b []string) string {
+	edits := diffLines(a, b)
+	changed := false
+	for _, e := range edits {
+		if e.kind != editEqual {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		return ""
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "--- %s\n", fromName)
+	fmt.Fprintf(buf, "+++ %s\n", toName)
+
+	// aLine and bLine track the (zero-based) line of each input that the
+	// edit at index i corresponds to.
+	aLine := make([]int, len(edits)+1)
+	bLine := make([]int, len(edits)+1)
+	for i, e := range edits {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if e.kind != editInsert {
+			aLine[i+1]++
+		}
+
+		if e.kind != editDelete {
+			bLine[i+1]++
+		}
+	}
+
+	i := 0
+	for i < len(edits) {
+		if edits[i].kind == editEqual {
+			i++
+			continue
+		}
+
+		// a hunk starts a few lines of context before the first change and
+		// extends until we see more than two contexts' worth of unchanged
+		// lines in a row.
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		end := i
+		for end < len(edits) {
+			if edits[end].kind != editEqual {
+				end++
+				continue
+			}
+
+			run := end
+			for run < len(edits) && edits[run].kind == editEqual {
+				run++
+			}
+
+			if run == len(edits) || run-end > 2*diffContext {
+				end += diffContext
+				if end > len(edits) {
+					end = len(edits)
+				}
+
+				break
+			}
+
+			end = run
+		}
+
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n",
+			hunkRange(aLine[start], aLine[end]-aLine[start]),
+			hunkRange(bLine[start], bLine[end]-bLine[start]))
+		for _, e := range edits[start:end] {
+			switch e.kind {
+			case editEqual:
+				fmt.Fprintf(buf, " %s\n", e.line)
+			case editDelete:
+				fmt.Fprintf(buf, "-%s\n", e.line)
+			case editInsert:
+				fmt.Fprintf(buf, "+%s\n", e.line)
+			}
+		}
+
+		i = end
+	}
+
+	return buf.String()
+}
+
+// hunkRange formats a line range the way diff(1) does: ranges are one-based,
+// and an empty range names the line before it.
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+func diffLines(a, b []string) []edit {
+	// lcs[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var edits []edit
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{editEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{editDelete, a[i]})
+			i++
+		default:
+			edits = append(edits, edit{editInsert, b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		edits = append(edits, edit{editDelete, a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		edits = append(edits, edit{editInsert, b[j]})
+	}
+
+	return edits
+}
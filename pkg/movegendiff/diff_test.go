@@ -0,0 +1,54 @@
+package movegendiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+	t.Run("identical", func(tt *testing.T) {
+		lines := []string{"e2e3", "e2e4"}
+		assert.Equal(tt, "", UnifiedDiff("a", "b", lines, lines))
+	})
+
+	t.Run("missing-and-extra", func(tt *testing.T) {
+		expected := []string{"a2a3", "a2a4", "b1c3", "e5d6"}
+		actual := []string{"a2a3", "a2a4", "b1c3", "e5d5"}
+		diff := UnifiedDiff("reference", "apollo", expected, actual)
+		assert.Equal(tt, `--- reference
++++ apollo
+@@ -1,4 +1,4 @@
+ a2a3
+ a2a4
+ b1c3
+-e5d6
++e5d5
+`, diff)
+	})
+
+	t.Run("separate-hunks", func(tt *testing.T) {
+		expected := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+		actual := []string{"b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+		diff := UnifiedDiff("x", "y", expected, actual)
+		assert.Equal(tt, `--- x
++++ y
+@@ -1,4 +1,3 @@
+-a
+ b
+ c
+ d
+@@ -8,3 +7,4 @@
+ h
+ i
+ j
++k
+`, diff)
+	})
+
+	t.Run("empty-side", func(tt *testing.T) {
+		diff := UnifiedDiff("x", "y", nil, []string{"e2e4"})
+		assert.Equal(tt, "--- x\n+++ y\n@@ -0,0 +1 @@\n+e2e4\n", diff)
+	})
+}
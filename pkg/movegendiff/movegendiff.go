@@ -0,0 +1,122 @@
+// Package movegendiff contains the tooling used to debug the move generator.
+//
+// The perft command can stream every position it visits, together with the
+// legal moves the engine generated for it, as newline-delimited JSON. The
+// records in that stream can then be checked against the reference move
+// generator, which produces a unified diff for every position where the two
+// generators disagree.
+package movegendiff
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+	"github.com/swgillespie/apollo-ii/pkg/reference"
+)
+
+// A Record is a single position visited during a perft traversal, along with
+// the UCI strings of the legal moves the engine generated for it.
+type Record struct {
+	Fen   string   `json:"fen"`
+	Moves []string `json:"moves"`
+}
+
+// A Writer streams Records as newline-delimited JSON.
+type Writer struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewWriter creates a new Writer that writes to the given io.Writer. Callers
+// must call Flush once they are done writing records.
+func NewWriter(w io.Writer) *Writer {
+	buf := bufio.NewWriter(w)
+	return &Writer{buf, json.NewEncoder(buf)}
+}
+
+// Write writes a single Record to the stream.
+func (w *Writer) Write(record Record) error {
+	return w.enc.Encode(record)
+}
+
+// Flush flushes any buffered records to the underlying io.Writer.
+func (w *Writer) Flush() error {
+	return w.buf.Flush()
+}
+
+// A Reader reads a stream of newline-delimited JSON Records.
+type Reader struct {
+	dec *json.Decoder
+}
+
+// NewReader creates a new Reader that reads from the given io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{json.NewDecoder(r)}
+}
+
+// Read reads the next Record from the stream. It returns io.EOF when there
+// are no records remaining.
+func (r *Reader) Read() (Record, error) {
+	var record Record
+	err := r.dec.Decode(&record)
+	return record, err
+}
+
+// Walk traverses the game tree rooted at the given position to the given
+// depth, calling visit with a Record for every interior node. Traversal stops
+// at the first error returned by visit.
+func Walk(pos *engine.Position, depth int, visit func(Record) error) error {
+	if depth == 0 {
+		return nil
+	}
+
+	moves := pos.LegalMoves()
+	if err := visit(Record{pos.AsCanonicalFen(), uciStrings(moves)}); err != nil {
+		return err
+	}
+
+	for _, mov := range moves {
+		newPos := pos.Clone()
+		newPos.ApplyMove(mov)
+		if err := Walk(newPos, depth-1, visit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check compares the moves in the given Record against the moves produced by
+// the reference generator. It returns a unified diff from the reference moves
+// to the recorded moves, or the empty string if they agree.
+func Check(record Record) (string, error) {
+	pos, err := engine.MakePositionFromFen(record.Fen)
+	if err != nil {
+		return "", err
+	}
+
+	expected := uciStrings(reference.LegalMoves(pos))
+	actual := append([]string(nil), record.Moves...)
+	sort.Strings(actual)
+	return UnifiedDiff("reference", "apollo", expected, actual), nil
+}
+
+// Divergence formats a report for a Record whose moves disagreed with the
+// reference generator.
+func Divergence(record Record, diff string) string {
+	return fmt.Sprintf("Move generation divergence (fen %s):\n%s", record.Fen, diff)
+}
+
+func uciStrings(moves []engine.Move) []string {
+	strs := make([]string, 0, len(moves))
+	for _, mov := range moves {
+		strs = append(strs, mov.UciString())
+	}
+
+	sort.Strings(strs)
+	return strs
+}
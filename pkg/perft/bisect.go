@@ -0,0 +1,112 @@
+package perft
+
+import (
+	"sort"
+
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// A DivideOracle is a source of trusted divide output, usually an
+// ExternalEngine.
+type DivideOracle interface {
+	Divide(fen string, depth int) (map[string]uint64, error)
+}
+
+// BisectResults describes the smallest position that Bisect could find where
+// our move generator disagrees with the oracle.
+type BisectResults struct {
+	// Whether or not a disagreement was found at all. If this is false,
+	// the remaining fields are empty.
+	Found bool
+
+	// The FEN of the position where the move lists differ.
+	Fen string
+
+	// The moves, in UCI notation, that lead from the starting position to
+	// the position above.
+	Path []string
+
+	// The moves that the oracle generated but we did not.
+	Missing []string
+
+	// The moves that we generated but the oracle did not.
+	Extra []string
+}
+
+// Bisect compares our divide output with the oracle's, starting at the given
+// position and depth. Whenever the two disagree only on node counts, it
+// descends into the first move whose counts differ and tries again one ply
+// shallower, until it finds a position where the two disagree on the moves
+// themselves. Our moves are written in UCI notation as the given options ask,
+// which should match how the oracle has been configured to write its own.
+func Bisect(fenStr string, depth int, opts engine.Options, oracle DivideOracle) (*BisectResults, error) {
+	pos, err := engine.MakePositionFromFen(fenStr, engine.FenLenient(), engine.FenValidate())
+	if err != nil {
+		return nil, err
+	}
+
+	pos.SetOptions(opts)
+
+	results := new(BisectResults)
+	for ; depth > 0; depth-- {
+		fen := pos.AsFen()
+		theirs, err := oracle.Divide(fen, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		ours := make(map[string]engine.Move)
+		for _, move := range pos.LegalMoves() {
+			ours[move.UciStringWithOptions(pos.Options())] = move
+		}
+
+		for move := range theirs {
+			if _, ok := ours[move]; !ok {
+				results.Missing = append(results.Missing, move)
+			}
+		}
+
+		for move := range ours {
+			if _, ok := theirs[move]; !ok {
+				results.Extra = append(results.Extra, move)
+			}
+		}
+
+		if len(results.Missing) != 0 || len(results.Extra) != 0 {
+			sort.Strings(results.Missing)
+			sort.Strings(results.Extra)
+			results.Found = true
+			results.Fen = fen
+			return results, nil
+		}
+
+		// same moves, so look for the first one whose subtree differs.
+		moves := make([]string, 0, len(ours))
+		for move := range ours {
+			moves = append(moves, move)
+		}
+
+		sort.Strings(moves)
+		var next *engine.Position
+		for _, move := range moves {
+			newPos := pos.Clone()
+			newPos.ApplyMove(ours[move])
+			if countNodes(newPos, depth-1, makePlyBuffers(depth-1)) != theirs[move] {
+				results.Path = append(results.Path, move)
+				next = newPos
+				break
+			}
+		}
+
+		if next == nil {
+			// every subtree agrees; there's nothing to find.
+			return &BisectResults{}, nil
+		}
+
+		pos = next
+	}
+
+	// we can only get here if the oracle disagreed on the node count of a
+	// subtree of depth zero, which is always exactly one node.
+	return &BisectResults{}, nil
+}
@@ -0,0 +1,189 @@
+package perft
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+const startingFen = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+// after 1. e4, black's knight on b8 can't go to c6 in the scripted engine.
+const scriptedFen = "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1"
+const scriptedMove = "b8c6"
+
+// scriptedDivide is our own divide output, except that scriptedMove is never
+// generated from scriptedFen. It plays the part of a trusted engine that
+// disagrees with us about one position.
+func scriptedDivide(fen string, depth int) (map[string]uint64, error) {
+	pos, err := engine.MakePositionFromFen(fen)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]uint64)
+	for _, move := range pos.LegalMoves() {
+		if pos.AsFen() == scriptedFen && move.UciString() == scriptedMove {
+			continue
+		}
+
+		if depth == 1 {
+			results[move.UciString()] = 1
+			continue
+		}
+
+		newPos := pos.Clone()
+		newPos.ApplyMove(move)
+		child, err := scriptedDivide(newPos.AsFen(), depth-1)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nodes := range child {
+			results[move.UciString()] += nodes
+		}
+	}
+
+	return results, nil
+}
+
+type scriptedOracle struct{}
+
+func (scriptedOracle) Divide(fen string, depth int) (map[string]uint64, error) {
+	return scriptedDivide(fen, depth)
+}
+
+// TestHelperProcess isn't a real test. It's the scripted stand-in engine that
+// TestExternalEngineBisect launches as a child process.
+func TestHelperProcess(t *testing.T) {
+	args := os.Args
+	if len(args) == 0 || args[len(args)-1] != "scripted-engine" {
+		return
+	}
+
+	fen := ""
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "uci":
+			fmt.Println("id name scripted-stand-in")
+			fmt.Println("uciok")
+		case line == "isready":
+			fmt.Println("readyok")
+		case strings.HasPrefix(line, "position fen "):
+			fen = strings.TrimPrefix(line, "position fen ")
+		case strings.HasPrefix(line, "go perft "):
+			depth, _ := strconv.Atoi(strings.TrimPrefix(line, "go perft "))
+			results, _ := scriptedDivide(fen, depth)
+			var total uint64
+			for move, nodes := range results {
+				fmt.Printf("%s: %d\n", move, nodes)
+				total += nodes
+			}
+
+			fmt.Printf("\nNodes searched: %d\n\n", total)
+		case line == "quit":
+			os.Exit(0)
+		}
+	}
+
+	os.Exit(0)
+}
+
+func TestBisect(t *testing.T) {
+	t.Parallel()
+	results, err := Bisect(startingFen, 3, engine.DefaultOptions(), scriptedOracle{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.True(t, results.Found)
+	assert.Equal(t, scriptedFen, results.Fen)
+	assert.Equal(t, []string{"e2e4"}, results.Path)
+	assert.Empty(t, results.Missing)
+	assert.Equal(t, []string{scriptedMove}, results.Extra)
+}
+
+func TestBisectNoDivergence(t *testing.T) {
+	t.Parallel()
+	results, err := Bisect(scriptedFen, 2, engine.DefaultOptions(), scriptedOracle{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// the scripted engine disagrees with us at the root of this search,
+	// which isn't a node count mismatch.
+	assert.True(t, results.Found)
+	assert.Empty(t, results.Path)
+
+	results, err = Bisect(startingFen, 1, engine.DefaultOptions(), scriptedOracle{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.False(t, results.Found)
+}
+
+// chess960Oracle is our own divide output, with castles written as the king
+// capturing its own rook.
+type chess960Oracle struct{}
+
+func (chess960Oracle) Divide(fen string, depth int) (map[string]uint64, error) {
+	pos, err := engine.MakePositionFromFen(fen)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := engine.DefaultOptions()
+	opts.UciChess960 = true
+	pos.SetOptions(opts)
+	return Divide(pos, depth)
+}
+
+func TestBisectOptions(t *testing.T) {
+	t.Parallel()
+	const fen = "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"
+	chess960 := engine.DefaultOptions()
+	chess960.UciChess960 = true
+	results, err := Bisect(fen, 2, chess960, chess960Oracle{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.False(t, results.Found)
+
+	// written the standard way, our castles aren't the oracle's.
+	results, err = Bisect(fen, 2, engine.DefaultOptions(), chess960Oracle{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.True(t, results.Found)
+	assert.Equal(t, []string{"e1a1", "e1h1"}, results.Missing)
+	assert.Equal(t, []string{"e1c1", "e1g1"}, results.Extra)
+}
+
+func TestExternalEngineBisect(t *testing.T) {
+	external, err := StartExternalEngine(os.Args[0], "-test.run=TestHelperProcess", "--", "scripted-engine")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	defer external.Close()
+	results, err := Bisect(startingFen, 2, engine.DefaultOptions(), external)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.True(t, results.Found)
+	assert.Equal(t, scriptedFen, results.Fen)
+	assert.Equal(t, []string{"e2e4"}, results.Path)
+	assert.Equal(t, []string{scriptedMove}, results.Extra)
+}
@@ -0,0 +1,134 @@
+package perft
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// An ExternalEngine is another chess engine, running as a child process and
+// speaking UCI, that is used as an oracle for perft results. The engine must
+// support the non-standard "go perft <depth>" command popularized by
+// Stockfish, which prints one "<move>: <nodes>" line per legal move followed
+// by a "Nodes searched: <total>" line.
+type ExternalEngine struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Scanner
+}
+
+// StartExternalEngine launches the engine binary at the given path with the
+// given arguments and performs the UCI handshake with it.
+func StartExternalEngine(path string, args ...string) (*ExternalEngine, error) {
+	cmd := exec.Command(path, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	engine := &ExternalEngine{cmd, stdin, bufio.NewScanner(stdout)}
+	if err := engine.send("uci"); err != nil {
+		engine.Close()
+		return nil, err
+	}
+
+	if err := engine.waitFor("uciok"); err != nil {
+		engine.Close()
+		return nil, err
+	}
+
+	return engine, nil
+}
+
+// SetOption sets a UCI option on the external engine.
+func (e *ExternalEngine) SetOption(name, value string) error {
+	return e.send(fmt.Sprintf("setoption name %s value %s", name, value))
+}
+
+// Divide asks the external engine for its divide output for the given
+// position and depth.
+func (e *ExternalEngine) Divide(fen string, depth int) (map[string]uint64, error) {
+	if err := e.send("isready"); err != nil {
+		return nil, err
+	}
+
+	if err := e.waitFor("readyok"); err != nil {
+		return nil, err
+	}
+
+	if err := e.send(fmt.Sprintf("position fen %s", fen)); err != nil {
+		return nil, err
+	}
+
+	if err := e.send(fmt.Sprintf("go perft %d", depth)); err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]uint64)
+	for e.stdout.Scan() {
+		line := strings.TrimSpace(e.stdout.Text())
+		if strings.HasPrefix(line, "Nodes searched") {
+			return results, nil
+		}
+
+		// engines are free to print other things (info strings, a blank
+		// line before the total), so ignore anything that doesn't look
+		// like a line of divide output.
+		fields := strings.Split(line, ":")
+		if len(fields) != 2 {
+			continue
+		}
+
+		nodes, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		results[strings.TrimSpace(fields[0])] = nodes
+	}
+
+	return nil, e.scanError()
+}
+
+// Close asks the external engine to quit and waits for it to exit.
+func (e *ExternalEngine) Close() error {
+	e.send("quit")
+	e.stdin.Close()
+	return e.cmd.Wait()
+}
+
+func (e *ExternalEngine) send(command string) error {
+	_, err := io.WriteString(e.stdin, command+"\n")
+	return err
+}
+
+func (e *ExternalEngine) waitFor(response string) error {
+	for e.stdout.Scan() {
+		if strings.TrimSpace(e.stdout.Text()) == response {
+			return nil
+		}
+	}
+
+	return e.scanError()
+}
+
+func (e *ExternalEngine) scanError() error {
+	if err := e.stdout.Err(); err != nil {
+		return err
+	}
+
+	return errors.New("external engine exited unexpectedly")
+}
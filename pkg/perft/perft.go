@@ -2,6 +2,7 @@ package perft
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/swgillespie/apollo-ii/pkg/engine"
 )
@@ -16,59 +17,199 @@ type PerftResults struct {
 	Checkmates uint64
 }
 
-func Perft(fenStr string, depth int) (*PerftResults, error) {
+// Perft walks the game tree from the given position to the given depth and
+// counts the leaf nodes, using the engine's global options. Any FEN options
+// are passed on to MakePositionFromFen, e.g. to select a variant.
+func Perft(fenStr string, depth int, opts ...engine.FenOption) (*PerftResults, error) {
+	return PerftWithOptions(fenStr, depth, engine.CurrentOptions(), opts...)
+}
+
+// PerftWithOptions is like Perft, but uses the given engine options instead
+// of the global ones. The walk is divided between options.Threads goroutines,
+// and every position is checked with CheckInvariants if options.DebugChecks
+// is set, since the positions of the walk all share the root's options.
+func PerftWithOptions(fenStr string, depth int, options engine.Options, opts ...engine.FenOption) (*PerftResults, error) {
 	if depth < 0 {
 		return nil, fmt.Errorf("invalid ply depth: %d", depth)
 	}
 
-	pos, err := engine.MakePositionFromFen(fenStr)
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	opts = append([]engine.FenOption{engine.FenLenient(), engine.FenValidate()}, opts...)
+	pos, err := engine.MakePositionFromFen(fenStr, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	pos.SetOptions(options)
+	if options.Threads == 1 || depth == 0 {
+		w := new(walker)
+		w.walk(pos, depth, makePlyBuffers(depth))
+		return &w.results, nil
+	}
+
+	// the moves from the root are queued up and each thread walks the trees
+	// beneath them, one at a time, with its own walker.
+	moves := pos.LegalMoves()
+	work := make(chan engine.Move, options.WorkQueueSize)
+	walkers := make([]walker, options.Threads)
+	var wg sync.WaitGroup
+	for i := range walkers {
+		w := &walkers[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buffers := makePlyBuffers(depth)
+			for move := range work {
+				w.walkMove(pos, move, depth, buffers)
+			}
+		}()
+	}
+
+	for _, move := range moves {
+		work <- move
+	}
+
+	close(work)
+	wg.Wait()
 	results := new(PerftResults)
-	perftImpl(results, pos, depth)
+	if len(moves) == 0 {
+		results.Checkmates++
+	}
+
+	for i := range walkers {
+		results.add(&walkers[i].results)
+	}
+
 	return results, nil
 }
 
-func perftImpl(results *PerftResults, pos *engine.Position, depth int) {
+// add adds the counts of other to these results.
+func (r *PerftResults) add(other *PerftResults) {
+	r.Nodes += other.Nodes
+	r.Captures += other.Captures
+	r.EnPassants += other.EnPassants
+	r.Castles += other.Castles
+	r.Promotions += other.Promotions
+	r.Checks += other.Checks
+	r.Checkmates += other.Checkmates
+}
+
+// plyBuffers holds a move list and a position for each ply of a walk of the
+// game tree, so that the walk can reuse them instead of allocating at every
+// node.
+type plyBuffers struct {
+	lists     []engine.MoveList
+	positions []engine.Position
+}
+
+func makePlyBuffers(depth int) plyBuffers {
+	return plyBuffers{
+		lists:     make([]engine.MoveList, depth),
+		positions: make([]engine.Position, depth),
+	}
+}
+
+// next returns the buffers for the ply below the one that uses the first of
+// them.
+func (b plyBuffers) next() plyBuffers {
+	return plyBuffers{lists: b.lists[1:], positions: b.positions[1:]}
+}
+
+// A walker walks the game tree for Perft and counts what it finds. Each
+// thread of a perft has its own.
+type walker struct {
+	results PerftResults
+}
+
+func (w *walker) walk(pos *engine.Position, depth int, buffers plyBuffers) {
 	if depth == 0 {
-		results.Nodes++
+		w.results.Nodes++
 		return
 	}
 
-	seenLegalMove := false
-	for _, move := range pos.PseudolegalMoves() {
-		newPos := pos.Clone()
-		toMove := pos.SideToMove()
-		newPos.ApplyMove(move)
-		if !newPos.IsCheck(toMove) {
-			seenLegalMove = true
-			if move.IsCapture() {
-				results.Captures++
-			}
+	// the position buffer for this ply is free until walkMove uses it for
+	// the first child, so it doubles as the scratch for generating moves.
+	moves := &buffers.lists[0]
+	moves.Clear()
+	pos.GenerateLegalMoves(moves, &buffers.positions[0])
+	for i := 0; i < moves.Len(); i++ {
+		w.walkMove(pos, moves.At(i), depth, buffers)
+	}
 
-			if move.IsEnPassant() {
-				results.EnPassants++
-			}
+	if moves.Len() == 0 {
+		w.results.Checkmates++
+	}
+}
 
-			if move.IsKingsideCastle() || move.IsQueensideCastle() {
-				results.Castles++
-			}
+// walkMove counts the given move from the given position and then walks the
+// game tree beneath it.
+func (w *walker) walkMove(pos *engine.Position, move engine.Move, depth int, buffers plyBuffers) {
+	newPos := &buffers.positions[0]
+	*newPos = *pos
+	newPos.ApplyMove(move)
+	if move.IsCapture() {
+		w.results.Captures++
+	}
 
-			if move.IsPromotion() {
-				results.Promotions++
-			}
+	if move.IsEnPassant() {
+		w.results.EnPassants++
+	}
 
-			if newPos.IsCheck(toMove.Toggle()) {
-				results.Checks++
-			}
+	if move.IsKingsideCastle() || move.IsQueensideCastle() {
+		w.results.Castles++
+	}
 
-			perftImpl(results, newPos, depth-1)
-		}
+	if move.IsPromotion() {
+		w.results.Promotions++
 	}
 
-	if !seenLegalMove {
-		results.Checkmates++
+	if newPos.IsCheck(pos.SideToMove().Toggle()) {
+		w.results.Checks++
 	}
+
+	w.walk(newPos, depth-1, buffers.next())
+}
+
+// Divide calculates the number of leaf nodes at the given depth beneath each
+// legal move from the given position. The returned map is keyed by the UCI
+// string of each move, which is how engines conventionally report "divide"
+// output, written with the position's options.
+func Divide(pos *engine.Position, depth int) (map[string]uint64, error) {
+	if depth < 1 {
+		return nil, fmt.Errorf("invalid divide depth: %d", depth)
+	}
+
+	results := make(map[string]uint64)
+	buffers := makePlyBuffers(depth - 1)
+	for _, move := range pos.LegalMoves() {
+		newPos := pos.Clone()
+		newPos.ApplyMove(move)
+		results[move.UciStringWithOptions(pos.Options())] = countNodes(newPos, depth-1, buffers)
+	}
+
+	return results, nil
+}
+
+// countNodes counts the leaf nodes of the game tree at the given depth, without
+// any of the additional bookkeeping that Perft does.
+func countNodes(pos *engine.Position, depth int, buffers plyBuffers) uint64 {
+	if depth == 0 {
+		return 1
+	}
+
+	var nodes uint64
+	moves := &buffers.lists[0]
+	moves.Clear()
+	newPos := &buffers.positions[0]
+	pos.GenerateLegalMoves(moves, newPos)
+	for i := 0; i < moves.Len(); i++ {
+		*newPos = *pos
+		newPos.ApplyMove(moves.At(i))
+		nodes += countNodes(newPos, depth-1, buffers.next())
+	}
+
+	return nodes
 }
@@ -1,10 +1,12 @@
 package perft
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
 )
 
 // PERFT numbers for a number of predetermined board positions have been
@@ -63,8 +65,6 @@ var perftTests = [...]expectedPerft{
 }
 
 func TestPerftCorrectness(t *testing.T) {
-	Initialize()
-
 	t.Parallel()
 	for _, test := range perftTests {
 		t.Run(fmt.Sprintf("perft-%s-depth-%d", test.fen, test.depth), func(tt *testing.T) {
@@ -83,3 +83,195 @@ func TestPerftCorrectness(t *testing.T) {
 		})
 	}
 }
+
+// Chess960 positions from the published Chess960 perft reference list, for
+// which only the node counts are given.
+var chess960PerftTests = [...]struct {
+	fen   string
+	depth int
+	nodes uint64
+}{
+	{"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9", 3, 12189},
+	{"2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w HEhe - 1 9", 3, 18002},
+	{"b1q1rrkb/pppppppp/3nn3/8/P7/1PPP4/4PPPP/BQNNRKRB w GE - 1 9", 3, 10471},
+	{"qbbnnrkr/2pp2pp/p7/1p2pp2/8/P3PP2/1PPP1KPP/QBBNNR1R w hf - 0 9", 3, 13440},
+	{"1nbbnrkr/p1p1ppp1/3p4/1p3P1p/3Pq2P/8/PPP1P1P1/QNBBNRKR w HFhf - 0 9", 3, 31058},
+	{"qnbnr1kr/ppp1b1pp/4p3/3p1p2/8/2NPP3/PPP1BPPP/QNB1R1KR w HEhe - 1 9", 3, 26578},
+	{"qnbnr1kr/ppp1b1pp/4p3/3p1p2/8/2NPP3/PPP1BPPP/QNB1R1KR w HEhe - 1 9", 4, 824055},
+}
+
+func TestChess960PerftCorrectness(t *testing.T) {
+	t.Parallel()
+	for _, test := range chess960PerftTests {
+		test := test
+		t.Run(fmt.Sprintf("perft-%s-depth-%d", test.fen, test.depth), func(tt *testing.T) {
+			results, err := Perft(test.fen, test.depth)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, test.nodes, results.Nodes)
+		})
+	}
+}
+
+// Perft numbers for variants. The counts from the starting positions and the
+// Crazyhouse, atomic, racing kings and Horde counts are the published ones.
+// The other antichess, three-check and king of the hill counts are unverified:
+// they were produced by this engine and haven't been checked against any other
+// move generator, so they only guard against regressions.
+var variantPerftTests = [...]struct {
+	variant engine.Variant
+	fen     string
+	depth   int
+	nodes   uint64
+}{
+	{engine.Antichess, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1", 3, 8067},
+	{engine.Antichess, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1", 4, 153299},
+	{engine.Antichess, "rnb1kbnr/pppp1ppp/8/4p3/7q/4P3/PPPP1PPP/RNBQKBNR w - - 0 1", 3, 323},
+	{engine.Antichess, "8/2p5/8/8/8/8/1P6/4K3 w - - 0 1", 6, 678},
+	{engine.ThreeCheck, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+3 0 1", 4, 197281},
+	{engine.ThreeCheck, "r1bqkbnr/pppp1ppp/2n5/4p3/2B1P3/5Q2/PPPP1PPP/RNB1K1NR w KQkq - 1+2 4 4", 3, 49106},
+	{engine.ThreeCheck, "4k3/8/8/8/8/8/8/R3K2R w KQ - 1+1 0 1", 3, 3012},
+	{engine.KingOfTheHill, "4k3/8/8/8/8/8/8/4K3 w - - 0 1", 5, 7922},
+	{engine.KingOfTheHill, "8/8/8/8/8/2K5/8/2k5 w - - 0 1", 4, 479},
+	{engine.Crazyhouse, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1", 4, 197281},
+	{engine.Crazyhouse, "2k5/8/8/8/8/8/8/4K3[QRBNPqrbnp] w - - 0 1", 2, 75353},
+	{engine.Crazyhouse, "2k5/8/8/8/8/8/8/4K3[Qn] w - - 0 1", 3, 88634},
+	{engine.Crazyhouse, "r1bqk2r/pppp1ppp/2n1p3/4P3/1b1Pn3/2NB1N2/PPP2PPP/R1BQK2R[] b KQkq - 0 1", 3, 58057},
+	{engine.Crazyhouse, "4k3/1Q~6/8/8/4b3/8/Kpp5/8/ b - - 0 1", 4, 132758},
+	{engine.Atomic, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 4, 197326},
+	{engine.Atomic, "rn2kb1r/1pp1p2p/p2q1pp1/3P4/2P3b1/4PN2/PP3PPP/R2QKB1R b KQkq - 0 1", 3, 45237},
+	{engine.Atomic, "rn1qkb1r/p5pp/2p5/3p4/N3P3/5P2/PPP4P/R1BQK3 w Qkq - 0 1", 3, 23353},
+	{engine.Atomic, "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", 3, 14295},
+	{engine.Atomic, "8/8/8/8/3k4/3K4/8/8 w - - 0 1", 4, 3064},
+	{engine.RacingKings, "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1", 4, 296242},
+	{engine.RacingKings, "4brn1/2K2k2/8/8/8/8/8/8 w - - 0 1", 5, 12981},
+	{engine.RacingKings, "6r1/2K5/5k2/8/3R4/8/8/8 w - - 0 1", 4, 86041},
+	{engine.Horde, "rnbqkbnr/pppppppp/8/1PP2PP1/PPPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 1", 5, 265223},
+	{engine.Horde, "4k3/pp4q1/3P2p1/8/P3PP2/PPP2r2/PPP5/PPPP4 b - - 0 1", 4, 56539},
+	{engine.Horde, "k7/5p2/4p2P/3p2P1/2p2P2/1p2P2P/p2P2P1/2P2P2 w - - 0 1", 4, 33781},
+}
+
+func TestVariantPerftCorrectness(t *testing.T) {
+	t.Parallel()
+	for _, test := range variantPerftTests {
+		test := test
+		t.Run(fmt.Sprintf("perft-%s-%s-depth-%d", test.variant.Name(), test.fen, test.depth), func(tt *testing.T) {
+			results, err := Perft(test.fen, test.depth, engine.FenVariant(test.variant))
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, test.nodes, results.Nodes)
+		})
+	}
+}
+
+// The engine's debug checks are too slow to leave on for every perft test,
+// so this runs the smaller ones again with the checks on, which catches
+// moves that leave a position inconsistent even when the node count happens
+// to come out right. It isn't parallel, since debug checks are global.
+func TestPerftWithDebugChecks(t *testing.T) {
+	engine.SetDebugChecks(true)
+	defer engine.SetDebugChecks(false)
+
+	const maxNodes = 100000
+	check := func(fen string, depth int, nodes uint64, opts ...engine.FenOption) {
+		if nodes > maxNodes {
+			return
+		}
+
+		results, err := Perft(fen, depth, opts...)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		assert.Equal(t, nodes, results.Nodes, fen)
+	}
+
+	for _, test := range perftTests {
+		check(test.fen, test.depth, test.nodes)
+	}
+
+	for _, test := range chess960PerftTests {
+		check(test.fen, test.depth, test.nodes)
+	}
+
+	for _, test := range variantPerftTests {
+		check(test.fen, test.depth, test.nodes, engine.FenVariant(test.variant))
+	}
+}
+
+// This test isn't parallel, since it toggles the global UCI_Chess960 option.
+func TestDividePositionOptionsOverrideGlobal(t *testing.T) {
+	defer engine.SetUciChess960(false)
+	const fen = "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"
+	divide := func(opts engine.Options) map[string]uint64 {
+		pos, err := engine.MakePositionFromFen(fen)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		pos.SetOptions(opts)
+		results, err := Divide(pos, 1)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		return results
+	}
+
+	chess960 := engine.DefaultOptions()
+	chess960.UciChess960 = true
+
+	engine.SetUciChess960(false)
+	results := divide(chess960)
+	assert.Contains(t, results, "e1h1")
+	assert.NotContains(t, results, "e1g1")
+
+	engine.SetUciChess960(true)
+	results = divide(engine.DefaultOptions())
+	assert.Contains(t, results, "e1g1")
+	assert.NotContains(t, results, "e1h1")
+}
+
+func TestPerftWithOptions(t *testing.T) {
+	t.Parallel()
+	for _, test := range [...]struct {
+		name  string
+		fen   string
+		depth int
+	}{
+		{"kiwipete", "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", 3},
+		{"promotions", "n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1", 3},
+		{"checkmated", "rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", 2},
+	} {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			serial := engine.DefaultOptions()
+			serial.DebugChecks = true
+			expected, err := PerftWithOptions(test.fen, test.depth, serial)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			parallel := serial
+			parallel.Threads = 4
+			parallel.WorkQueueSize = 0
+			results, err := PerftWithOptions(test.fen, test.depth, parallel)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, expected, results)
+		})
+	}
+
+	t.Run("invalid", func(tt *testing.T) {
+		opts := engine.DefaultOptions()
+		opts.Threads = 0
+		_, err := PerftWithOptions("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 1, opts)
+		assert.True(tt, errors.Is(err, engine.OptionsInvalidValueError), "%v", err)
+	})
+}
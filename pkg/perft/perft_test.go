@@ -0,0 +1,70 @@
+package perft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// Perft spends most of its time copying positions, so this benchmark tracks
+// both the speed of the move generator and the allocations made per node.
+func BenchmarkPerft(b *testing.B) {
+	for _, bench := range [...]struct {
+		name  string
+		fen   string
+		depth int
+	}{
+		{"start", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 3},
+		{"kiwipete", "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", 2},
+		{"endgame", "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", 4},
+	} {
+		bench := bench
+		b.Run(bench.name, func(bb *testing.B) {
+			bb.ReportAllocs()
+			var nodes uint64
+			for i := 0; i < bb.N; i++ {
+				results, err := Perft(bench.fen, bench.depth)
+				if err != nil {
+					bb.Fatal(err)
+				}
+
+				nodes += results.Nodes
+			}
+
+			bb.ReportMetric(float64(nodes)/bb.Elapsed().Seconds(), "nodes/s")
+		})
+	}
+}
+
+// Once its ply buffers are allocated, a walk of the game tree shouldn't
+// allocate at all, in any variant whose legality filter tries moves out on a
+// scratch position.
+//
+// This test counts the allocations of the whole program, so it can't run in
+// parallel with the other tests.
+func TestWalkDoesNotAllocate(t *testing.T) {
+	for _, test := range [...]struct {
+		name    string
+		variant engine.Variant
+		fen     string
+		depth   int
+	}{
+		{"chess", engine.Standard, "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", 2},
+		{"atomic", engine.Atomic, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 3},
+		{"racingkings", engine.RacingKings, "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1", 3},
+	} {
+		pos, err := engine.MakePositionFromFen(test.fen, engine.FenVariant(test.variant))
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		var w walker
+		buffers := makePlyBuffers(test.depth)
+		allocs := testing.AllocsPerRun(10, func() {
+			w.walk(pos, test.depth, buffers)
+		})
+
+		assert.Zero(t, allocs, test.name)
+	}
+}
@@ -0,0 +1,134 @@
+package perft
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// Swapping the colors of every piece, flipping the board vertically and
+// giving the move to the other side gives a position that is the same game
+// seen from the other side of the board. Anything the engine computes about
+// a position must agree with what it computes about its mirror, so comparing
+// the two over a large set of positions catches bugs that only show up for
+// one color, such as a mistake in one side's castling or pawn tables.
+//
+// Evaluation should be checked here too, once the engine has one.
+
+const symmetryCorpus = "testdata/symmetry.fen"
+
+// symmetryDepth is deep enough to reach every kind of move from each corpus
+// position while keeping the test quick; depth 3 takes tens of seconds.
+const symmetryDepth = 2
+
+type symmetryPosition struct {
+	variant string
+	fen     string
+}
+
+func readSymmetryCorpus(t *testing.T) []symmetryPosition {
+	file, err := os.Open(symmetryCorpus)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	defer file.Close()
+	var positions []symmetryPosition
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 2)
+		if !assert.Len(t, fields, 2, "malformed corpus line: %s", line) {
+			t.FailNow()
+		}
+
+		positions = append(positions, symmetryPosition{fields[0], fields[1]})
+	}
+
+	if !assert.NoError(t, scanner.Err()) {
+		t.FailNow()
+	}
+
+	return positions
+}
+
+// fenOptions returns the FEN options to parse a corpus position with.
+func (s symmetryPosition) fenOptions() ([]engine.FenOption, error) {
+	if s.variant == "chess960" {
+		return []engine.FenOption{engine.FenChess960()}, nil
+	}
+
+	variant, err := engine.LookupVariant(s.variant)
+	if err != nil {
+		return nil, err
+	}
+
+	return []engine.FenOption{engine.FenVariant(variant)}, nil
+}
+
+// mirrorResult returns the result of a game as seen from the other side of
+// the board.
+func mirrorResult(result engine.Result) engine.Result {
+	switch result {
+	case engine.WhiteWins:
+		return engine.BlackWins
+	case engine.BlackWins:
+		return engine.WhiteWins
+	}
+
+	return result
+}
+
+func TestColorSymmetry(t *testing.T) {
+	t.Parallel()
+	positions := readSymmetryCorpus(t)
+	assert.NotEmpty(t, positions)
+	for _, test := range positions {
+		test := test
+		t.Run(test.variant+" "+test.fen, func(tt *testing.T) {
+			tt.Parallel()
+			opts, err := test.fenOptions()
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			pos, err := engine.MakePositionFromFen(test.fen, opts...)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			mirror := pos.Mirror()
+			mirrorFen := mirror.AsFen()
+			assert.NoError(tt, mirror.CheckInvariants())
+			assert.Len(tt, mirror.LegalMoves(), len(pos.LegalMoves()))
+			assert.Equal(tt, pos.IsCheck(pos.SideToMove()), mirror.IsCheck(mirror.SideToMove()))
+
+			result, over := pos.Outcome()
+			mirrorResultGot, mirrorOver := mirror.Outcome()
+			assert.Equal(tt, over, mirrorOver)
+			if over && mirrorOver {
+				assert.Equal(tt, mirrorResult(result), mirrorResultGot)
+			}
+
+			results, err := Perft(test.fen, symmetryDepth, opts...)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			mirrorResults, err := Perft(mirrorFen, symmetryDepth, opts...)
+			if !assert.NoError(tt, err, "mirror: %s", mirrorFen) {
+				tt.FailNow()
+			}
+
+			assert.Equal(tt, results, mirrorResults, "mirror: %s", mirrorFen)
+		})
+	}
+}
@@ -0,0 +1,413 @@
+// Package reference implements a deliberately simple and slow legal move
+// generator that is used to cross-check the engine's bitboard move generator.
+//
+// Nothing in this package uses the engine's attack tables or bitboards. The
+// board is copied out of an engine.Position into a 0x88 mailbox and every
+// piece walks its movement vectors one square at a time, so a bug in the
+// engine's precomputed tables can't be hidden by the same bug showing up here.
+// Legality is determined the obvious way: make the move on a copy of the board
+// and see whether the king of the side that moved can be captured.
+package reference
+
+import (
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// A square on the 0x88 board. The low nibble is the file and the high nibble
+// is the rank; any square with a bit set in 0x88 is off the board.
+type square int
+
+const noSquare = square(-1)
+
+func (s square) onBoard() bool {
+	return s&0x88 == 0
+}
+
+func (s square) rank() int {
+	return int(s >> 4)
+}
+
+func (s square) file() int {
+	return int(s & 7)
+}
+
+func fromEngine(sq engine.Square) square {
+	return square(int(sq.Rank())<<4 | int(sq.File()))
+}
+
+func (s square) toEngine() engine.Square {
+	return engine.MakeSquare(engine.Rank(s.rank()), engine.File(s.file()))
+}
+
+// Movement vectors on the 0x88 board.
+var (
+	knightVectors = [...]square{33, 31, 18, 14, -14, -18, -31, -33}
+	kingVectors   = [...]square{1, -1, 16, -16, 15, 17, -15, -17}
+	bishopVectors = [...]square{15, 17, -15, -17}
+	rookVectors   = [...]square{1, -1, 16, -16}
+)
+
+// A piece on the mailbox. The zero value is an empty square.
+type piece struct {
+	occupied bool
+	kind     engine.PieceKind
+	color    engine.Color
+}
+
+// board is the mailbox representation of a position that the reference
+// generator operates on.
+type board struct {
+	squares    [128]piece
+	sideToMove engine.Color
+	epSquare   square
+
+	// castling rights and the squares of the castling rooks, indexed by
+	// color.
+	kingside, queenside         [2]bool
+	kingsideRook, queensideRook [2]square
+}
+
+func newBoard(pos *engine.Position) *board {
+	b := &board{sideToMove: pos.SideToMove(), epSquare: noSquare}
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		if p, ok := pos.PieceAt(sq); ok {
+			b.squares[fromEngine(sq)] = piece{true, p.Kind(), p.Color()}
+		}
+	}
+
+	if pos.HasEnPassantSquare() {
+		b.epSquare = fromEngine(pos.EnPassantSquare())
+	}
+
+	for _, color := range [...]engine.Color{engine.White, engine.Black} {
+		b.kingside[color] = pos.CanCastleKingside(color)
+		b.queenside[color] = pos.CanCastleQueenside(color)
+		b.kingsideRook[color] = fromEngine(pos.KingsideCastleRook(color))
+		b.queensideRook[color] = fromEngine(pos.QueensideCastleRook(color))
+	}
+
+	return b
+}
+
+// attacked returns whether or not the given square is attacked by any piece
+// of the given color. It works backwards from the target square, looking
+// outwards along every vector for a piece that could reach it.
+func (b *board) attacked(target square, by engine.Color) bool {
+	is := func(sq square, kinds ...engine.PieceKind) bool {
+		if !sq.onBoard() {
+			return false
+		}
+
+		p := b.squares[sq]
+		if !p.occupied || p.color != by {
+			return false
+		}
+
+		for _, kind := range kinds {
+			if p.kind == kind {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// pawns attack diagonally forward, so look diagonally backward.
+	pawnRank := square(-16)
+	if by == engine.Black {
+		pawnRank = 16
+	}
+
+	if is(target+pawnRank+1, engine.Pawn) || is(target+pawnRank-1, engine.Pawn) {
+		return true
+	}
+
+	for _, v := range knightVectors {
+		if is(target+v, engine.Knight) {
+			return true
+		}
+	}
+
+	for _, v := range kingVectors {
+		if is(target+v, engine.King) {
+			return true
+		}
+	}
+
+	slide := func(vectors []square, kinds ...engine.PieceKind) bool {
+		for _, v := range vectors {
+			for sq := target + v; sq.onBoard(); sq += v {
+				if b.squares[sq].occupied {
+					if is(sq, kinds...) {
+						return true
+					}
+
+					break
+				}
+			}
+		}
+
+		return false
+	}
+
+	return slide(bishopVectors[:], engine.Bishop, engine.Queen) ||
+		slide(rookVectors[:], engine.Rook, engine.Queen)
+}
+
+// inCheck returns whether or not any king of the given color is attacked.
+// Boards without a king are never in check.
+func (b *board) inCheck(color engine.Color) bool {
+	for sq := square(0); sq < 128; sq++ {
+		p := b.squares[sq]
+		if sq.onBoard() && p.occupied && p.kind == engine.King && p.color == color {
+			if b.attacked(sq, color.Toggle()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// apply returns a copy of this board with the given move played on it. Only
+// the parts of the board that matter for legality (piece placement) are
+// updated.
+func (b *board) apply(mov engine.Move) *board {
+	next := *b
+	source := fromEngine(mov.Source())
+	dest := fromEngine(mov.Destination())
+	moving := next.squares[source]
+	next.squares[source] = piece{}
+	if mov.IsEnPassant() {
+		// the captured pawn is on the rank we left, on the file we entered.
+		next.squares[source&0x70|dest&7] = piece{}
+	}
+
+	if mov.IsPromotion() {
+		moving.kind = mov.PromotionPiece()
+	}
+
+	if mov.IsCastle() {
+		// castles are encoded as the king capturing its own rook.
+		kingTarget, rookTarget := castleTargets(source&0x70, mov.IsKingsideCastle())
+		rook := next.squares[dest]
+		next.squares[dest] = piece{}
+		next.squares[rookTarget] = rook
+		dest = kingTarget
+	}
+
+	next.squares[dest] = moving
+	next.sideToMove = b.sideToMove.Toggle()
+	return &next
+}
+
+// pseudolegal generates every move that obeys the movement rules of the
+// pieces, without regard to whether or not the mover's king is left in check.
+func (b *board) pseudolegal() []engine.Move {
+	var moves []engine.Move
+	us := b.sideToMove
+	them := us.Toggle()
+	add := func(from, to square, flags func(source, dest engine.Square) engine.Move) {
+		moves = append(moves, flags(from.toEngine(), to.toEngine()))
+	}
+
+	addPromotions := func(from, to square, capture bool) {
+		for _, kind := range [...]engine.PieceKind{engine.Knight, engine.Bishop, engine.Rook, engine.Queen} {
+			if capture {
+				moves = append(moves, engine.MakePromotionCaptureMove(from.toEngine(), to.toEngine(), kind))
+			} else {
+				moves = append(moves, engine.MakePromotionMove(from.toEngine(), to.toEngine(), kind))
+			}
+		}
+	}
+
+	for from := square(0); from < 128; from++ {
+		if !from.onBoard() {
+			continue
+		}
+
+		p := b.squares[from]
+		if !p.occupied || p.color != us {
+			continue
+		}
+
+		switch p.kind {
+		case engine.Pawn:
+			forward, startRank, promoRank := square(16), 1, 7
+			if us == engine.Black {
+				forward, startRank, promoRank = -16, 6, 0
+			}
+
+			one := from + forward
+			if one.onBoard() && !b.squares[one].occupied {
+				if one.rank() == promoRank {
+					addPromotions(from, one, false)
+				} else {
+					add(from, one, engine.MakeQuietMove)
+				}
+
+				two := one + forward
+				if from.rank() == startRank && !b.squares[two].occupied {
+					add(from, two, engine.MakeDoublePawnPushMove)
+				}
+			}
+
+			for _, side := range [...]square{1, -1} {
+				to := from + forward + side
+				if !to.onBoard() {
+					continue
+				}
+
+				target := b.squares[to]
+				if target.occupied && target.color == them {
+					if to.rank() == promoRank {
+						addPromotions(from, to, true)
+					} else {
+						add(from, to, engine.MakeCaptureMove)
+					}
+				} else if !target.occupied && to == b.epSquare {
+					// the pawn being captured sits beside us, on the
+					// file of the en-passant square.
+					victim := b.squares[from+side]
+					if victim.occupied && victim.color == them && victim.kind == engine.Pawn {
+						add(from, to, engine.MakeEnPassantMove)
+					}
+				}
+			}
+		case engine.Knight:
+			b.leap(&moves, from, knightVectors[:])
+		case engine.King:
+			b.leap(&moves, from, kingVectors[:])
+			b.castles(&moves, from)
+		case engine.Bishop:
+			b.slide(&moves, from, bishopVectors[:])
+		case engine.Rook:
+			b.slide(&moves, from, rookVectors[:])
+		case engine.Queen:
+			b.slide(&moves, from, bishopVectors[:])
+			b.slide(&moves, from, rookVectors[:])
+		}
+	}
+
+	return moves
+}
+
+func (b *board) leap(moves *[]engine.Move, from square, vectors []square) {
+	for _, v := range vectors {
+		to := from + v
+		if !to.onBoard() {
+			continue
+		}
+
+		target := b.squares[to]
+		if !target.occupied {
+			*moves = append(*moves, engine.MakeQuietMove(from.toEngine(), to.toEngine()))
+		} else if target.color != b.sideToMove {
+			*moves = append(*moves, engine.MakeCaptureMove(from.toEngine(), to.toEngine()))
+		}
+	}
+}
+
+func (b *board) slide(moves *[]engine.Move, from square, vectors []square) {
+	for _, v := range vectors {
+		for to := from + v; to.onBoard(); to += v {
+			target := b.squares[to]
+			if !target.occupied {
+				*moves = append(*moves, engine.MakeQuietMove(from.toEngine(), to.toEngine()))
+				continue
+			}
+
+			if target.color != b.sideToMove {
+				*moves = append(*moves, engine.MakeCaptureMove(from.toEngine(), to.toEngine()))
+			}
+
+			break
+		}
+	}
+}
+
+// castleTargets returns the squares that the king and rook land on when
+// castling on the given rank, which are the same in standard chess and
+// Chess960.
+func castleTargets(rank square, kingside bool) (king, rook square) {
+	if kingside {
+		return rank | 6, rank | 5
+	}
+
+	return rank | 2, rank | 3
+}
+
+// castles generates castling moves for a king on the given square. The king
+// must be on its back rank with the castling rook beside it on the same rank,
+// every square that either of them travels over must be empty (other than the
+// squares they start on), and the king may not start on, pass over or land on
+// an attacked square.
+func (b *board) castles(moves *[]engine.Move, from square) {
+	us := b.sideToMove
+	rank := square(0x00)
+	if us == engine.Black {
+		rank = 0x70
+	}
+
+	if from&0x70 != rank {
+		return
+	}
+
+	isRook := func(sq square) bool {
+		p := b.squares[sq]
+		return p.occupied && p.color == us && p.kind == engine.Rook
+	}
+
+	// between returns every square from a to b on the back rank, inclusive.
+	between := func(a, b square) []square {
+		if a > b {
+			a, b = b, a
+		}
+
+		var squares []square
+		for sq := a; sq <= b; sq++ {
+			squares = append(squares, sq)
+		}
+
+		return squares
+	}
+
+	unobstructed := func(rook square, kingside bool) bool {
+		kingTarget, rookTarget := castleTargets(rank, kingside)
+		for _, sq := range append(between(from, kingTarget), between(rook, rookTarget)...) {
+			if sq != from && sq != rook && b.squares[sq].occupied {
+				return false
+			}
+		}
+
+		for _, sq := range between(from, kingTarget) {
+			if b.attacked(sq, us.Toggle()) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if rook := b.kingsideRook[us]; b.kingside[us] && isRook(rook) && unobstructed(rook, true) {
+		*moves = append(*moves, engine.MakeKingsideCastleMove(from.toEngine(), rook.toEngine()))
+	}
+
+	if rook := b.queensideRook[us]; b.queenside[us] && isRook(rook) && unobstructed(rook, false) {
+		*moves = append(*moves, engine.MakeQueensideCastleMove(from.toEngine(), rook.toEngine()))
+	}
+}
+
+// LegalMoves returns every legal move available to the side to move in the
+// given position.
+func LegalMoves(pos *engine.Position) []engine.Move {
+	b := newBoard(pos)
+	var legal []engine.Move
+	for _, mov := range b.pseudolegal() {
+		if !b.apply(mov).inCheck(b.sideToMove) {
+			legal = append(legal, mov)
+		}
+	}
+
+	return legal
+}
@@ -0,0 +1,109 @@
+package reference
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swgillespie/apollo-ii/pkg/engine"
+)
+
+// engineLegalMoves is the engine's notion of legal moves: every pseudo-legal
+// move that doesn't leave the mover in check.
+func engineLegalMoves(pos *engine.Position) []engine.Move {
+	var legal []engine.Move
+	toMove := pos.SideToMove()
+	for _, mov := range pos.PseudolegalMoves() {
+		newPos := pos.Clone()
+		newPos.ApplyMove(mov)
+		if !newPos.IsCheck(toMove) {
+			legal = append(legal, mov)
+		}
+	}
+
+	return legal
+}
+
+func sortedMoves(moves []engine.Move) []engine.Move {
+	sorted := append([]engine.Move(nil), moves...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// assertSameMoves checks the given reference moves against the moves the
+// engine generates for the same position.
+func assertSameMoves(t *testing.T, pos *engine.Position, moves []engine.Move) bool {
+	expected := sortedMoves(moves)
+	actual := sortedMoves(engineLegalMoves(pos))
+	if assert.ObjectsAreEqual(expected, actual) {
+		return true
+	}
+
+	return assert.Equal(t, expected, actual, "move generation divergence (fen %s)", pos.AsFen())
+}
+
+var referenceTests = [...]struct {
+	fen   string
+	moves int
+}{
+	{"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 20},
+	{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", 48},
+	{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", 14},
+	{"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", 6},
+	{"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8", 44},
+	{"r4rk1/1pp1qppp/p1np1n2/2b1p1B1/2B1P1b1/P1NP1N2/1PP1QPPP/R4RK1 w - - 0 10", 46},
+	{"8/8/8/2k5/3Pp3/8/8/4K3 b - d3 0 1", 9},
+}
+
+func TestReferenceMoveCounts(t *testing.T) {
+	t.Parallel()
+	for _, test := range referenceTests {
+		test := test
+		t.Run(fmt.Sprintf("moves-%s", test.fen), func(tt *testing.T) {
+			pos, err := engine.MakePositionFromFen(test.fen)
+			if !assert.NoError(tt, err) {
+				tt.FailNow()
+			}
+
+			moves := LegalMoves(pos)
+			assert.Len(tt, moves, test.moves)
+			assertSameMoves(tt, pos, moves)
+		})
+	}
+}
+
+// TestRandomGames plays a large number of random games from the starting
+// position and checks that the engine and the reference generator agree on
+// the legal moves at every ply. The games are split into independently seeded
+// batches so that they can run in parallel.
+func TestRandomGames(t *testing.T) {
+	batches, gamesPerBatch, maxPlies := 8, 250, 200
+	if testing.Short() {
+		gamesPerBatch = 10
+	}
+
+	for batch := 0; batch < batches; batch++ {
+		seed := int64(batch)
+		t.Run(fmt.Sprintf("batch-%d", batch), func(tt *testing.T) {
+			tt.Parallel()
+			rng := rand.New(rand.NewSource(seed))
+			for game := 0; game < gamesPerBatch; game++ {
+				pos := engine.MakeDefaultPosition()
+				for ply := 0; ply < maxPlies; ply++ {
+					moves := LegalMoves(pos)
+					if !assertSameMoves(tt, pos, moves) {
+						tt.FailNow()
+					}
+
+					if len(moves) == 0 {
+						break
+					}
+
+					pos.ApplyMove(moves[rng.Intn(len(moves))])
+				}
+			}
+		})
+	}
+}